@@ -4,12 +4,15 @@
 package acctest
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"testing"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider/fwprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,6 +58,9 @@ var err error
 var placementGroupName string
 var CertCRN string
 var UpdatedCertCRN string
+var SecretsManagerInstanceCRN string
+var SecretsManagerSecretCRN string
+var UpdatedSecretsManagerSecretCRN string
 var RegionName string
 var ISZoneName string
 var ISCIDR string
@@ -64,6 +70,7 @@ var InstanceProfileName string
 var InstanceProfileNameUpdate string
 var IsBareMetalServerProfileName string
 var IsBareMetalServerImage string
+var ISCertificateCrn string
 var DedicatedHostProfileName string
 var DedicatedHostGroupID string
 var InstanceDiskProfileName string
@@ -224,6 +231,21 @@ func init() {
 		fmt.Println("[WARN] Set the environment variable IBM_UPDATE_CERT_CRN for testing ibm_container_alb_cert resource else it is set to default value")
 	}
 
+	SecretsManagerInstanceCRN = os.Getenv("IBM_SECRETS_MANAGER_INSTANCE_CRN")
+	if SecretsManagerInstanceCRN == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_SECRETS_MANAGER_INSTANCE_CRN for testing ibm_container_ingress_instance resource else tests will fail if this is not set correctly")
+	}
+
+	SecretsManagerSecretCRN = os.Getenv("IBM_SECRETS_MANAGER_SECRET_CRN")
+	if SecretsManagerSecretCRN == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_SECRETS_MANAGER_SECRET_CRN for testing ibm_container_ingress_secret_opaque resource else tests will fail if this is not set correctly")
+	}
+
+	UpdatedSecretsManagerSecretCRN = os.Getenv("IBM_SECRETS_MANAGER_UPDATE_SECRET_CRN")
+	if UpdatedSecretsManagerSecretCRN == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_SECRETS_MANAGER_UPDATE_SECRET_CRN for testing ibm_container_ingress_secret_opaque resource else tests will fail if this is not set correctly")
+	}
+
 	CsRegion = os.Getenv("IBM_CONTAINER_REGION")
 	if CsRegion == "" {
 		CsRegion = "eu-de"
@@ -465,6 +487,12 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IsBareMetalServerImage for testing ibm_is_bare_metal_server resource else it is set to default value 'r006-2d1f36b0-df65-4570-82eb-df7ae5f778b1'")
 	}
 
+	ISCertificateCrn = os.Getenv("IS_CERTIFICATE_CRN")
+	if ISCertificateCrn == "" {
+		ISCertificateCrn = "crn:v1:bluemix:public:secrets-manager:us-south:a/aa5a471f75bc456fac416bf02c4ba6de:9a6d1a09-4b9f-4d37-a0c0-6f9e5b34bbd9:secret:5a4b9e4c-43e9-9a3c-5d04-2a6a3f7b6de3"
+		fmt.Println("[INFO] Set the environment variable IS_CERTIFICATE_CRN for testing ibm_is_vpn_server resource else it is set to default value 'crn:v1:bluemix:public:secrets-manager:us-south:a/aa5a471f75bc456fac416bf02c4ba6de:9a6d1a09-4b9f-4d37-a0c0-6f9e5b34bbd9:secret:5a4b9e4c-43e9-9a3c-5d04-2a6a3f7b6de3'")
+	}
+
 	DedicatedHostName = os.Getenv("IS_DEDICATED_HOST_NAME")
 	if DedicatedHostName == "" {
 		DedicatedHostName = "tf-dhost-01" // for next gen infrastructure
@@ -882,11 +910,25 @@ func init() {
 var TestAccProviders map[string]*schema.Provider
 var TestAccProvider *schema.Provider
 
+// TestAccProtoV5ProviderFactories serve TestAccProvider muxed with the
+// framework provider, they are needed to test the resources and data sources
+// of fwprovider.
+var TestAccProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error)
+
 func init() {
 	TestAccProvider = provider.Provider()
 	TestAccProviders = map[string]*schema.Provider{
 		"ibm": TestAccProvider,
 	}
+	TestAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+		"ibm": func() (tfprotov5.ProviderServer, error) {
+			serverFactory, err := fwprovider.ProtoV5ProviderServerFactory(context.Background(), TestAccProvider)
+			if err != nil {
+				return nil, err
+			}
+			return serverFactory(), nil
+		},
+	}
 }
 
 func TestProvider(t *testing.T) {
@@ -899,7 +941,23 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = provider.Provider()
 }
 
+func TestProvider_mux(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, err := fwprovider.ProtoV5ProviderServerFactory(ctx, provider.Provider())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+}
+
 func TestAccPreCheck(t *testing.T) {
+	UseCassette(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -912,6 +970,7 @@ func TestAccPreCheck(t *testing.T) {
 }
 
 func TestAccPreCheckEnterprise(t *testing.T) {
+	UseCassette(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -919,6 +978,7 @@ func TestAccPreCheckEnterprise(t *testing.T) {
 }
 
 func TestAccPreCheckEnterpriseAccountImport(t *testing.T) {
+	UseCassette(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
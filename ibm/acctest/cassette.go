@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"log"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/recorder"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	// Seeds math/rand with the time, init replaces the seed when recording or
+	// replaying
+	_ "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+// Cassettes records the API calls of the acceptance tests or replays them,
+// depending on IBM_ACCTEST_MODE. It is nil when the mode isn't set.
+var Cassettes *recorder.Recorder
+
+// Credentials the acceptance tests require, set to placeholders in replay mode
+var replayCredentials = []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"}
+
+// Seed of the random names of the tests (acctest.RandIntRange,
+// acctest.RandString, ...) when recording or replaying. Every test starts
+// with it, so a replayed test draws the names of its recording whichever tests
+// ran before it.
+const cassetteRandSeed = 1
+
+func init() {
+	mode, err := recorder.ParseMode(os.Getenv("IBM_ACCTEST_MODE"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if mode == recorder.ModeOff {
+		return
+	}
+	dir := os.Getenv("IBM_ACCTEST_CASSETTES")
+	if dir == "" {
+		dir = "testdata/cassettes"
+	}
+	Cassettes = recorder.New(mode, dir)
+	// The clients and the IAM and UAA authentication of the session share
+	// the retry policy transport, so every API call goes to the cassette
+	conns.WrapTransport = Cassettes.Wrap
+	rand.Seed(cassetteRandSeed)
+
+	if mode == recorder.ModeReplay {
+		for _, env := range replayCredentials {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, recorder.Redacted)
+			}
+		}
+	}
+}
+
+// UseCassette records or replays the API calls of the test to the cassette
+// named after it. It is called by the acceptance test pre checks, tests not
+// using them call it first.
+func UseCassette(t *testing.T) {
+	if Cassettes == nil {
+		return
+	}
+	if err := Cassettes.Start(t.Name()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := Cassettes.Stop(); err != nil {
+			t.Error(err)
+		}
+		// The names of the next test are drawn before its pre check
+		rand.Seed(cassetteRandSeed)
+	})
+}
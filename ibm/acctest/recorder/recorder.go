@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package recorder records the HTTP interactions of the acceptance tests to
+// cassettes and replays them offline.
+//
+// In record mode the requests are sent to the cloud and every interaction is
+// appended to the cassette of the running test, which is saved when the test
+// ends. In replay mode the responses are served from the cassette, so the IDs
+// and timestamps returned by the APIs are the recorded ones. Tokens, API keys
+// and passwords are scrubbed before the cassettes are written.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode of the recorder, set by IBM_ACCTEST_MODE
+type Mode string
+
+const (
+	// ModeOff sends the requests to the cloud without recording them
+	ModeOff Mode = ""
+	// ModeRecord sends the requests to the cloud and records them
+	ModeRecord Mode = "record"
+	// ModeReplay serves the responses from the cassettes
+	ModeReplay Mode = "replay"
+)
+
+// ParseMode validates the value of IBM_ACCTEST_MODE.
+func ParseMode(v string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(v))); mode {
+	case ModeOff, ModeRecord, ModeReplay:
+		return mode, nil
+	}
+	return ModeOff, fmt.Errorf("[ERROR] Invalid acceptance test mode %q, expected %q or %q", v, ModeRecord, ModeReplay)
+}
+
+// Cassette holds the interactions of a test, in the order they were recorded.
+type Cassette struct {
+	Name         string         `json:"name"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response the API returned.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method  string        `json:"method"`
+	URL     string        `json:"url"`
+	Headers gohttp.Header `json:"headers,omitempty"`
+	Body    string        `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int           `json:"status_code"`
+	Headers    gohttp.Header `json:"headers,omitempty"`
+	Body       string        `json:"body,omitempty"`
+}
+
+// Recorder switches between the cassettes of the tests. A single test runs
+// at a time: tests running in parallel would share the cassette.
+type Recorder struct {
+	mode Mode
+	dir  string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	// Interactions of the cassette already replayed
+	replayed []bool
+}
+
+// New returns a recorder in the given mode storing the cassettes in dir.
+func New(mode Mode, dir string) *Recorder {
+	return &Recorder{mode: mode, dir: dir}
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Path returns the file of the cassette with the given name.
+func (r *Recorder) Path(name string) string {
+	return filepath.Join(r.dir, strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(name)+".json")
+}
+
+// Start makes name the current cassette. In replay mode the cassette is
+// loaded from its file. Starting the current cassette again does nothing.
+func (r *Recorder) Start(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette != nil && r.cassette.Name == name {
+		return nil
+	}
+	cassette := &Cassette{Name: name}
+	if r.mode == ModeReplay {
+		data, err := ioutil.ReadFile(r.Path(name))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error reading the cassette of %s, record it with IBM_ACCTEST_MODE=record: %s", name, err)
+		}
+		if err := json.Unmarshal(data, cassette); err != nil {
+			return fmt.Errorf("[ERROR] Error parsing the cassette %s: %s", r.Path(name), err)
+		}
+	}
+	r.cassette = cassette
+	r.replayed = make([]bool, len(cassette.Interactions))
+	return nil
+}
+
+// Stop ends the current cassette. In record mode the cassette is saved.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cassette := r.cassette
+	if cassette == nil {
+		return nil
+	}
+	r.cassette = nil
+	if r.mode == ModeReplay {
+		unused := 0
+		for _, replayed := range r.replayed {
+			if !replayed {
+				unused++
+			}
+		}
+		if unused > 0 {
+			log.Printf("[DEBUG] %d interactions of the cassette %s were not replayed", unused, cassette.Name)
+		}
+		return nil
+	}
+	if r.mode != ModeRecord {
+		return nil
+	}
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return fmt.Errorf("[ERROR] Error creating the cassette directory %s: %s", r.dir, err)
+	}
+	if err := ioutil.WriteFile(r.Path(cassette.Name), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("[ERROR] Error saving the cassette %s: %s", r.Path(cassette.Name), err)
+	}
+	return nil
+}
+
+// Wrap returns a transport recording or replaying the requests sent to base.
+func (r *Recorder) Wrap(base gohttp.RoundTripper) gohttp.RoundTripper {
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	return &transport{recorder: r, base: base}
+}
+
+type transport struct {
+	recorder *Recorder
+	base     gohttp.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	switch t.recorder.mode {
+	case ModeRecord:
+		return t.record(req)
+	case ModeReplay:
+		return t.recorder.replay(req)
+	}
+	return t.base.RoundTrip(req)
+}
+
+func (t *transport) record(req *gohttp.Request) (*gohttp.Response, error) {
+	var reqBody []byte
+	if req.Body != nil && req.Body != gohttp.NoBody {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     scrubURL(req.URL),
+			Headers: scrubHeaders(req.Header),
+			Body:    scrubBody(req.Header.Get("Content-Type"), reqBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    scrubHeaders(resp.Header),
+			Body:       scrubBody(resp.Header.Get("Content-Type"), respBody),
+		},
+	}
+
+	t.recorder.mu.Lock()
+	defer t.recorder.mu.Unlock()
+	if t.recorder.cassette != nil {
+		t.recorder.cassette.Interactions = append(t.recorder.cassette.Interactions, interaction)
+	}
+	return resp, nil
+}
+
+// replay serves the first interaction not replayed yet with the method and
+// URL of req, falling back to the ones with the same path when the query
+// differs. When all of them were replayed the last one is served again, the
+// provider may read a resource more often than during the recording.
+func (r *Recorder) replay(req *gohttp.Request) (*gohttp.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cassette == nil {
+		return nil, fmt.Errorf("[ERROR] %s %s was sent outside of an acceptance test, no cassette to replay", req.Method, req.URL.Redacted())
+	}
+
+	url := scrubURL(req.URL)
+	path := strings.SplitN(url, "?", 2)[0]
+	match, fallback, last := -1, -1, -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Request.Method != req.Method || strings.SplitN(interaction.Request.URL, "?", 2)[0] != path {
+			continue
+		}
+		last = i
+		if r.replayed[i] {
+			continue
+		}
+		if interaction.Request.URL == url {
+			match = i
+			break
+		}
+		if fallback < 0 {
+			fallback = i
+		}
+	}
+	if match < 0 {
+		match = fallback
+	}
+	if match < 0 {
+		match = last
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("[ERROR] %s %s is not in the cassette %s, record it again with IBM_ACCTEST_MODE=record", req.Method, url, r.cassette.Name)
+	}
+	r.replayed[match] = true
+
+	recorded := r.cassette.Interactions[match].Response
+	header := gohttp.Header{}
+	for k, v := range recorded.Headers {
+		header[k] = append([]string(nil), v...)
+	}
+	return &gohttp.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, gohttp.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package recorder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func testToken(claims map[string]interface{}) string {
+	payload, _ := json.Marshal(claims)
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".c2lnbmF0dXJl"
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	token := testToken(map[string]interface{}{"iam_id": "IBMid-123", "email": "jane@example.com", "account": map[string]interface{}{"bss": "abc"}, "exp": 1600000000})
+	calls := 0
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/identity/token":
+			fmt.Fprintf(w, `{"access_token": %q, "refresh_token": "opaque", "expiration": 1600000000}`, token)
+		case "/v1/vpcs":
+			fmt.Fprintf(w, `{"id": "r006-%d", "created_at": "2021-01-01T00:00:00Z", "big": 12345678901234567890}`, calls)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	rec := New(ModeRecord, dir)
+	client := &gohttp.Client{Transport: rec.Wrap(nil)}
+	if err := rec.Start("TestAccVPC/basic"); err != nil {
+		t.Fatal(err)
+	}
+	form := url.Values{"grant_type": {"urn:ibm:params:oauth:grant-type:apikey"}, "apikey": {"secret-key"}}
+	resp, err := client.Post(server.URL+"/identity/token", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	for i := 0; i < 2; i++ {
+		req, _ := gohttp.NewRequest("GET", server.URL+"/v1/vpcs?version=2021-01-01", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(rec.Path("TestAccVPC/basic"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cassette := string(data)
+	for _, secret := range []string{"secret-key", "opaque", "jane@example.com", "signature", "Bearer"} {
+		if strings.Contains(cassette, secret) {
+			t.Errorf("Expected %q to be scrubbed from the cassette %s", secret, cassette)
+		}
+	}
+
+	// Replay without the server
+	server.Close()
+	rec = New(ModeReplay, dir)
+	client = &gohttp.Client{Transport: rec.Wrap(nil)}
+	if err := rec.Start("TestAccVPC/basic"); err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Stop()
+
+	resp, err = client.Post(server.URL+"/identity/token", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tokenResponse map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&tokenResponse)
+	resp.Body.Close()
+	if tokenResponse["expiration"] != float64(scrubbedTokenExpiration) {
+		t.Errorf("Expected the expiration to be pushed back, got %v", tokenResponse["expiration"])
+	}
+	parts := strings.Split(tokenResponse["access_token"].(string), ".")
+	if len(parts) != 3 {
+		t.Fatalf("Expected a JWT, got %v", tokenResponse["access_token"])
+	}
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims map[string]interface{}
+	json.Unmarshal(payload, &claims)
+	if claims["iam_id"] != "IBMid-123" || claims["email"] != "user@example.com" || claims["account"].(map[string]interface{})["bss"] != "abc" {
+		t.Errorf("Unexpected claims %v", claims)
+	}
+
+	for _, expected := range []string{"r006-2", "r006-3", "r006-3"} {
+		resp, err := client.Get(server.URL + "/v1/vpcs?version=2021-01-01")
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), expected) || !strings.Contains(string(body), "12345678901234567890") {
+			t.Errorf("Expected %s to be replayed, got %s", expected, body)
+		}
+	}
+
+	if _, err := client.Get(server.URL + "/v1/subnets"); err == nil {
+		t.Error("Expected an error for a request missing from the cassette")
+	}
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	rec := New(ModeReplay, t.TempDir())
+	if err := rec.Start("TestAccMissing"); err == nil {
+		t.Error("Expected an error for a missing cassette")
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	for v, expected := range map[string]Mode{"": ModeOff, "record": ModeRecord, "Replay": ModeReplay} {
+		if mode, err := ParseMode(v); err != nil || mode != expected {
+			t.Errorf("%q: expected %q, got %q, %v", v, expected, mode, err)
+		}
+	}
+	if _, err := ParseMode("playback"); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+}
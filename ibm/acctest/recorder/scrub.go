@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Redacted replaces the secrets in the cassettes
+const Redacted = "REDACTED"
+
+// Headers dropped from the cassettes. Content-Length no longer matches the
+// body once it is scrubbed.
+var scrubbedHeaders = map[string]bool{
+	"Authorization":        true,
+	"Cookie":               true,
+	"Set-Cookie":           true,
+	"X-Auth-Token":         true,
+	"X-Auth-Refresh-Token": true,
+	"X-Auth-User-Token":    true,
+	"Refresh-Token":        true,
+	"Content-Length":       true,
+}
+
+// Keys of form fields, query parameters and JSON objects holding secrets
+var secretKeys = map[string]bool{
+	"apikey":                  true,
+	"api_key":                 true,
+	"password":                true,
+	"passcode":                true,
+	"client_secret":           true,
+	"refresh_token":           true,
+	"access_token":            true,
+	"delegated_refresh_token": true,
+	"uaa_access_token":        true,
+	"uaa_refresh_token":       true,
+	"token":                   true,
+	"iam_token":               true,
+	"ims_token":               true,
+}
+
+// Keys of JSON objects holding IAM tokens. The clients parse the tokens, so
+// they are replaced by unsigned tokens keeping the claims the provider reads.
+var tokenKeys = map[string]bool{
+	"access_token":            true,
+	"refresh_token":           true,
+	"delegated_refresh_token": true,
+	"uaa_access_token":        true,
+}
+
+// Claims kept in the scrubbed tokens
+var keptClaims = []string{"iam_id", "id", "realmid", "sub", "sub_type", "account", "iss", "aud", "grant_type", "scope", "client_id", "acr", "amr", "iat"}
+
+// Expiration of the scrubbed tokens, 2100-01-01, so that replays never
+// refresh them
+const scrubbedTokenExpiration = 4102444800
+
+func scrubHeaders(header map[string][]string) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+	scrubbed := map[string][]string{}
+	for k, v := range header {
+		if scrubbedHeaders[k] {
+			continue
+		}
+		scrubbed[k] = append([]string(nil), v...)
+	}
+	return scrubbed
+}
+
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	scrubbed.User = nil
+	query := scrubbed.Query()
+	changed := false
+	for k := range query {
+		if secretKeys[strings.ToLower(k)] {
+			query.Set(k, Redacted)
+			changed = true
+		}
+	}
+	if changed {
+		scrubbed.RawQuery = query.Encode()
+	}
+	return scrubbed.String()
+}
+
+func scrubBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return Redacted
+		}
+		for k := range form {
+			if secretKeys[strings.ToLower(k)] {
+				form.Set(k, Redacted)
+			}
+		}
+		return form.Encode()
+	}
+	// Numbers are kept as is, IDs may not fit in a float64
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return string(body)
+	}
+	scrubbed, err := json.Marshal(scrubJSON(doc))
+	if err != nil {
+		return Redacted
+	}
+	return string(scrubbed)
+}
+
+func scrubJSON(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			key := strings.ToLower(k)
+			if s, ok := item.(string); ok && tokenKeys[key] {
+				value[k] = scrubToken(s)
+			} else if secretKeys[key] {
+				value[k] = Redacted
+			} else {
+				value[k] = scrubJSON(item)
+			}
+		}
+		// IAM token responses, the clients check the expiration of the token
+		// against it
+		if _, ok := value["access_token"]; ok {
+			if _, ok := value["expiration"].(json.Number); ok {
+				value["expiration"] = json.Number(strconv.FormatInt(scrubbedTokenExpiration, 10))
+			}
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = scrubJSON(item)
+		}
+		return value
+	}
+	return v
+}
+
+// scrubToken replaces a JWT by an unsigned one with the non secret claims of
+// the original, which never expires. Other values are redacted.
+func scrubToken(token string) string {
+	parts := strings.Split(strings.TrimPrefix(token, "Bearer "), ".")
+	if len(parts) != 3 {
+		return Redacted
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return Redacted
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return Redacted
+	}
+	scrubbed := map[string]interface{}{}
+	for _, claim := range keptClaims {
+		if v, ok := claims[claim]; ok {
+			scrubbed[claim] = v
+		}
+	}
+	if _, ok := claims["email"]; ok {
+		scrubbed["email"] = "user@example.com"
+	}
+	scrubbed["exp"] = scrubbedTokenExpiration
+	payload, err = json.Marshal(scrubbed)
+	if err != nil {
+		return Redacted
+	}
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	signature := base64.RawURLEncoding.EncodeToString([]byte(Redacted))
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + signature
+}
@@ -5,15 +5,13 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -101,7 +99,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -110,19 +107,21 @@ import (
 	"github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 	"github.com/IBM/scc-go-sdk/v3/posturemanagementv1"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 // RetryAPIDelay - retry api delay
 const RetryAPIDelay = 5 * time.Second
 
-//BluemixRegion ...
+// BluemixRegion ...
 var BluemixRegion string
 
 var (
 	errEmptyBluemixCredentials = errors.New("ibmcloud_api_key or bluemix_api_key or iam_token and iam_refresh_token must be provided. Please see the documentation on how to configure it")
 )
 
-//UserConfig ...
+// UserConfig ...
 type UserConfig struct {
 	UserID      string
 	UserEmail   string
@@ -132,7 +131,7 @@ type UserConfig struct {
 	generation  int    `default:"2"`
 }
 
-//Config stores user provider input
+// Config stores user provider input
 type Config struct {
 	//BluemixAPIKey is the Bluemix api key
 	BluemixAPIKey string
@@ -155,13 +154,12 @@ type Config struct {
 	// Softlayer API Key
 	SoftLayerAPIKey string
 
-	//Retry Count for API calls
-	//Unexposed in the schema at this point as they are used only during session creation for a few calls
-	//When sdk implements it we an expose them for expected behaviour
-	//https://github.com/softlayer/softlayer-go/issues/41
+	//Retry Count for API calls, used as the default of RetryPolicy.MaxAttempts
 	RetryCount int
 	//Constant Retry Delay for API calls
 	RetryDelay time.Duration
+	// RetryPolicy shared by all the API clients
+	RetryPolicy RetryPolicy
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -181,13 +179,27 @@ type Config struct {
 	//IAM Refresh Token
 	IAMRefreshToken string
 
+	// Compute resource token file used to assume IAMTrustedProfileID
+	IAMCRTokenFile string
+	// Get the IAM token of the trusted profile from the VPC instance
+	// metadata service
+	IAMUseInstanceMetadata bool
+
 	// Zone
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+	// Endpoints from the provider endpoints block keyed by endpoint variable,
+	// they take precedence over EndpointsFile
+	Endpoints map[string]string
+
+	// DefaultTags are attached to every taggable resource
+	DefaultTags []string
+	// IgnoreTagPrefixes lists the tag key prefixes the provider never manages
+	IgnoreTagPrefixes []string
 }
 
-//Session stores the information required for communication with the SoftLayer and Bluemix API
+// Session stores the information required for communication with the SoftLayer and Bluemix API
 type Session struct {
 	// SoftLayerSesssion is the the SoftLayer session used to connect to the SoftLayer API
 	SoftLayerSession *slsession.Session
@@ -282,464 +294,615 @@ type ClientSession interface {
 	PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error)
 	ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error)
 	PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error)
+	DefaultTags() []string
+	IgnoreTagPrefixes() []string
 }
 
+// clientSession builds every service client on first use. Each accessor is
+// backed by a sync.Once so the client, and any error raised while configuring
+// it, is computed only once and then shared across concurrent callers.
 type clientSession struct {
 	session *Session
+	config  *Config
+
+	// Endpoints file contents, read once at configure time
+	fileMap map[string]interface{}
+
+	// IAM authentication, performed once before the first client that needs it
+	authOnce   sync.Once
+	authErr    error
+	iamAuthErr error
 
-	appidErr error
-	appidAPI *appid.AppIDManagementV4
+	// Cloud Foundry (UAA) authentication, only needed by CF and Functions
+	cfAuthOnce sync.Once
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	// Authenticator shared by the platform-services style clients
+	authenticatorOnce sync.Once
+	authenticator     core.Authenticator
+	authenticatorErr  error
 
+	// Set when authenticating with a compute resource token
+	crAuthenticator *ComputeResourceAuthenticator
+
+	appidOnce sync.Once
+	appidErr  error
+	appidAPI  *appid.AppIDManagementV4
+
+	apigatewayOnce sync.Once
+	apigatewayErr  error
+	apigatewayAPI  *apigateway.ApiGatewayControllerApiV1
+
+	accountOnce          sync.Once
 	accountConfigErr     error
 	bmxAccountServiceAPI accountv2.AccountServiceAPI
 
+	accountV1Once          sync.Once
 	accountV1ConfigErr     error
 	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
 
+	bmxUserOnce     sync.Once
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
+	csOnce       sync.Once
 	csConfigErr  error
 	csServiceAPI containerv1.ContainerServiceAPI
 
+	csv2Once       sync.Once
 	csv2ConfigErr  error
 	csv2ServiceAPI containerv2.ContainerServiceAPI
 
-	containerRegistryClientErr error
-	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
+	containerRegistryClientOnce sync.Once
+	containerRegistryClientErr  error
+	containerRegistryClient     *containerregistryv1.ContainerRegistryV1
 
-	certManagementErr error
-	certManagementAPI certificatemanager.CertificateManagerServiceAPI
+	certManagementOnce sync.Once
+	certManagementErr  error
+	certManagementAPI  certificatemanager.CertificateManagerServiceAPI
 
+	cfOnce       sync.Once
 	cfConfigErr  error
 	cfServiceAPI mccpv2.MccpServiceAPI
 
 	cisConfigErr  error
 	cisServiceAPI cisv1.CisServiceAPI
 
+	functionOnce      sync.Once
 	functionConfigErr error
 	functionClient    *whisk.Client
 
+	globalSearchOnce       sync.Once
 	globalSearchConfigErr  error
 	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
 
+	globalTaggingOnce       sync.Once
 	globalTaggingConfigErr  error
 	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
 
+	globalTaggingOnceV1       sync.Once
 	globalTaggingConfigErrV1  error
 	globalTaggingServiceAPIV1 globaltaggingv1.GlobalTaggingV1
 
-	ibmCloudShellClient    *ibmcloudshellv1.IBMCloudShellV1
-	ibmCloudShellClientErr error
+	ibmCloudShellClientOnce sync.Once
+	ibmCloudShellClient     *ibmcloudshellv1.IBMCloudShellV1
+	ibmCloudShellClientErr  error
 
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
+	userManagementOnce sync.Once
+	userManagementErr  error
+	userManagementAPI  usermanagementv2.UserManagementAPI
 
+	icdOnce       sync.Once
 	icdConfigErr  error
 	icdServiceAPI icdv4.ICDServiceAPI
 
-	cloudDatabasesClientErr error
-	cloudDatabasesClient    *clouddatabasesv5.CloudDatabasesV5
+	cloudDatabasesClientOnce sync.Once
+	cloudDatabasesClientErr  error
+	cloudDatabasesClient     *clouddatabasesv5.CloudDatabasesV5
 
+	resourceControllerConfigOnce sync.Once
 	resourceControllerConfigErr  error
 	resourceControllerServiceAPI controller.ResourceControllerAPI
 
+	resourceControllerConfigOncev2 sync.Once
 	resourceControllerConfigErrv2  error
 	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
 
+	resourceManagementConfigOncev2 sync.Once
 	resourceManagementConfigErrv2  error
 	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
 
+	resourceCatalogConfigOnce sync.Once
 	resourceCatalogConfigErr  error
 	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
 
-	ibmpiConfigErr error
-	ibmpiSession   *ibmpisession.IBMPISession
+	ibmpiConfigOnce sync.Once
+	ibmpiConfigErr  error
+	ibmpiSession    *ibmpisession.IBMPISession
 
-	kpErr error
-	kpAPI *kp.API
+	kpOnce sync.Once
+	kpErr  error
+	kpAPI  *kp.API
 
-	kmsErr error
-	kmsAPI *kp.API
+	kmsOnce sync.Once
+	kmsErr  error
+	kmsAPI  *kp.API
 
-	hpcsEndpointErr error
-	hpcsEndpointAPI hpcs.HPCSV2
+	hpcsEndpointOnce sync.Once
+	hpcsEndpointErr  error
+	hpcsEndpointAPI  hpcs.HPCSV2
 
+	pDNSOnce   sync.Once
 	pDNSClient *dns.DnsSvcsV1
 	pDNSErr    error
 
-	bluemixSessionErr error
-
-	pushServiceClient    *pushservicev1.PushServiceV1
-	pushServiceClientErr error
+	pushServiceClientOnce sync.Once
+	pushServiceClient     *pushservicev1.PushServiceV1
+	pushServiceClientErr  error
 
-	eventNotificationsApiClient    *eventnotificationsv1.EventNotificationsV1
-	eventNotificationsApiClientErr error
+	eventNotificationsApiClientOnce sync.Once
+	eventNotificationsApiClient     *eventnotificationsv1.EventNotificationsV1
+	eventNotificationsApiClientErr  error
 
-	appConfigurationClient    *appconfigurationv1.AppConfigurationV1
-	appConfigurationClientErr error
+	appConfigurationClientOnce sync.Once
+	appConfigurationClient     *appconfigurationv1.AppConfigurationV1
+	appConfigurationClientErr  error
 
-	vpcErr error
-	vpcAPI *vpc.VpcV1
+	vpcOnce sync.Once
+	vpcErr  error
+	vpcAPI  *vpc.VpcV1
 
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
+	directlinkOnce sync.Once
+	directlinkAPI  *dl.DirectLinkV1
+	directlinkErr  error
+	dlProviderOnce sync.Once
+	dlProviderAPI  *dlProviderV2.DirectLinkProviderV2
+	dlProviderErr  error
 
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
+	cosConfigOnce sync.Once
+	cosConfigErr  error
+	cosConfigAPI  *cosconfig.ResourceConfigurationV1
 
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
+	transitgatewayOnce sync.Once
+	transitgatewayAPI  *tg.TransitGatewayApisV1
+	transitgatewayErr  error
 
-	functionIAMNamespaceAPI functions.FunctionServiceAPI
-	functionIAMNamespaceErr error
+	functionIAMNamespaceOnce sync.Once
+	functionIAMNamespaceAPI  functions.FunctionServiceAPI
+	functionIAMNamespaceErr  error
 
 	// CIS Zones
+	cisZonesOnce     sync.Once
 	cisZonesErr      error
 	cisZonesV1Client *ciszonesv1.ZonesV1
 
 	// CIS Alerts
+	cisAlertsOnce   sync.Once
 	cisAlertsClient *cisalertsv1.AlertsV1
 	cisAlertsErr    error
 
 	// CIS dns service options
+	cisDNSOnce          sync.Once
 	cisDNSErr           error
 	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
 
 	// CIS dns bulk service options
+	cisDNSBulkOnce         sync.Once
 	cisDNSBulkErr          error
 	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
 
 	// CIS Global Load Balancer Pool service options
+	cisGLBPoolOnce   sync.Once
 	cisGLBPoolErr    error
 	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
 
 	// CIS GLB service options
+	cisGLBOnce   sync.Once
 	cisGLBErr    error
 	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
 
 	// CIS GLB health check service options
+	cisGLBHealthCheckOnce   sync.Once
 	cisGLBHealthCheckErr    error
 	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
 
 	// CIS IP service options
+	cisIPOnce   sync.Once
 	cisIPErr    error
 	cisIPClient *cisipv1.CisIpApiV1
 
 	// CIS Zone Rate Limits service options
+	cisRLOnce   sync.Once
 	cisRLErr    error
 	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
 
 	// CIS Page Rules service options
+	cisPageRuleOnce   sync.Once
 	cisPageRuleErr    error
 	cisPageRuleClient *cispagerulev1.PageRuleApiV1
 
 	// CIS Edge Functions service options
+	cisEdgeFunctionOnce   sync.Once
 	cisEdgeFunctionErr    error
 	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
 
 	// CIS SSL certificate service options
+	cisSSLOnce   sync.Once
 	cisSSLErr    error
 	cisSSLClient *cissslv1.SslCertificateApiV1
 
 	// CIS WAF Package service options
+	cisWAFPackageOnce   sync.Once
 	cisWAFPackageErr    error
 	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
 
 	// CIS Zone Setting service options
+	cisDomainSettingsOnce   sync.Once
 	cisDomainSettingsErr    error
 	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
 
 	// CIS Routing service options
+	cisRoutingOnce   sync.Once
 	cisRoutingErr    error
 	cisRoutingClient *cisroutingv1.RoutingV1
 
 	// CIS WAF Group service options
+	cisWAFGroupOnce   sync.Once
 	cisWAFGroupErr    error
 	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
 
 	// CIS Caching service options
+	cisCacheOnce   sync.Once
 	cisCacheErr    error
 	cisCacheClient *ciscachev1.CachingApiV1
 
 	// CIS Custom Pages service options
+	cisCustomPageOnce   sync.Once
 	cisCustomPageErr    error
 	cisCustomPageClient *ciscustompagev1.CustomPagesV1
 
 	// CIS Firewall Access rule service option
+	cisAccessRuleOnce   sync.Once
 	cisAccessRuleErr    error
 	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
 
 	// CIS User Agent Blocking Rule service option
+	cisUARuleOnce   sync.Once
 	cisUARuleErr    error
 	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
 
 	// CIS Firewall Lockdwon Rule service option
+	cisLockdownOnce   sync.Once
 	cisLockdownErr    error
 	cisLockdownClient *cislockdownv1.ZoneLockdownV1
 
 	// CIS LogpushJobs service option
+	cisLogpushJobsOnce   sync.Once
 	cisLogpushJobsClient *cislogpushjobsapiv1.LogpushJobsApiV1
 	cisLogpushJobsErr    error
 
 	// CIS Range app service option
+	cisRangeAppOnce   sync.Once
 	cisRangeAppErr    error
 	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
 
 	// CIS WAF rule service options
+	cisWAFRuleOnce   sync.Once
 	cisWAFRuleErr    error
 	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
 	//IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
+	iamIdentityOnce sync.Once
+	iamIdentityErr  error
+	iamIdentityAPI  *iamidentity.IamIdentityV1
 
 	//Resource Manager Option
-	resourceManagerErr error
-	resourceManagerAPI *resourcemanager.ResourceManagerV2
+	resourceManagerOnce sync.Once
+	resourceManagerErr  error
+	resourceManagerAPI  *resourcemanager.ResourceManagerV2
 
 	//Catalog Management Option
-	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
-	catalogManagementClientErr error
+	catalogManagementClientOnce sync.Once
+	catalogManagementClient     *catalogmanagementv1.CatalogManagementV1
+	catalogManagementClientErr  error
 
-	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
-	enterpriseManagementClientErr error
+	enterpriseManagementClientOnce sync.Once
+	enterpriseManagementClient     *enterprisemanagementv1.EnterpriseManagementV1
+	enterpriseManagementClientErr  error
 
 	//Resource Controller Option
-	resourceControllerErr   error
-	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
-	secretsManagerClient    *secretsmanagerv1.SecretsManagerV1
-	secretsManagerClientErr error
+	resourceControllerOnce   sync.Once
+	resourceControllerErr    error
+	resourceControllerAPI    *resourcecontroller.ResourceControllerV2
+	secretsManagerClientOnce sync.Once
+	secretsManagerClient     *secretsmanagerv1.SecretsManagerV1
+	secretsManagerClientErr  error
 
 	// Schematics service options
-	schematicsClient    *schematicsv1.SchematicsV1
-	schematicsClientErr error
+	schematicsClientOnce sync.Once
+	schematicsClient     *schematicsv1.SchematicsV1
+	schematicsClientErr  error
 
 	//Satellite service
-	satelliteClient    *kubernetesserviceapiv1.KubernetesServiceApiV1
-	satelliteClientErr error
+	satelliteClientOnce sync.Once
+	satelliteClient     *kubernetesserviceapiv1.KubernetesServiceApiV1
+	satelliteClientErr  error
 
 	//IAM Policy Management
-	iamPolicyManagementErr error
-	iamPolicyManagementAPI *iampolicymanagement.IamPolicyManagementV1
+	iamPolicyManagementOnce sync.Once
+	iamPolicyManagementErr  error
+	iamPolicyManagementAPI  *iampolicymanagement.IamPolicyManagementV1
 
 	//IAM Access Groups
-	iamAccessGroupsErr error
-	iamAccessGroupsAPI *iamaccessgroups.IamAccessGroupsV2
+	iamAccessGroupsOnce sync.Once
+	iamAccessGroupsErr  error
+	iamAccessGroupsAPI  *iamaccessgroups.IamAccessGroupsV2
 
 	// CIS Webhooks options
+	cisWebhooksOnce   sync.Once
 	cisWebhooksClient *ciswebhooksv1.WebhooksV1
 	cisWebhooksErr    error
 
 	// CIS Filters options
+	cisFiltersOnce   sync.Once
 	cisFiltersClient *cisfiltersv1.FiltersV1
 	cisFiltersErr    error
 
 	// CIS FirewallRules options
+	cisFirewallRulesOnce   sync.Once
 	cisFirewallRulesClient *cisfirewallrulesv1.FirewallRulesV1
 	cisFirewallRulesErr    error
 
 	//Atracker
-	atrackerClient    *atrackerv1.AtrackerV1
-	atrackerClientErr error
+	atrackerClientOnce sync.Once
+	atrackerClient     *atrackerv1.AtrackerV1
+	atrackerClientErr  error
 
 	//Satellite link service
-	satelliteLinkClient    *satellitelinkv1.SatelliteLinkV1
-	satelliteLinkClientErr error
+	satelliteLinkClientOnce sync.Once
+	satelliteLinkClient     *satellitelinkv1.SatelliteLinkV1
+	satelliteLinkClientErr  error
 
+	esSchemaRegistryOnce   sync.Once
 	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
 	esSchemaRegistryErr    error
 
 	// Security and Compliance Center (SCC)
-	findingsClient    *findingsv1.FindingsV1
-	findingsClientErr error
+	findingsClientOnce sync.Once
+	findingsClient     *findingsv1.FindingsV1
+	findingsClientErr  error
 
 	// Security and Compliance Center (SCC) Admin
-	adminServiceApiClient    *adminserviceapiv1.AdminServiceApiV1
-	adminServiceApiClientErr error
+	adminServiceApiClientOnce sync.Once
+	adminServiceApiClient     *adminserviceapiv1.AdminServiceApiV1
+	adminServiceApiClientErr  error
 
 	// Security and Compliance Center (SCC) Governance
-	configServiceApiClient    *configurationgovernancev1.ConfigurationGovernanceV1
-	configServiceApiClientErr error
+	configServiceApiClientOnce sync.Once
+	configServiceApiClient     *configurationgovernancev1.ConfigurationGovernanceV1
+	configServiceApiClientErr  error
 
 	//Security and Compliance Center (SCC) Compliance posture
-	postureManagementClientErr error
-	postureManagementClient    *posturemanagementv1.PostureManagementV1
+	postureManagementClientOnce sync.Once
+	postureManagementClientErr  error
+	postureManagementClient     *posturemanagementv1.PostureManagementV1
 
 	//Security and Compliance Center (SCC) Compliance posture v2
-	postureManagementClientv2    *posturemanagementv2.PostureManagementV2
-	postureManagementClientErrv2 error
+	postureManagementClientOncev2 sync.Once
+	postureManagementClientv2     *posturemanagementv2.PostureManagementV2
+	postureManagementClientErrv2  error
 
 	// context Based Restrictions (CBR)
-	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
-	contextBasedRestrictionsClientErr error
+	contextBasedRestrictionsClientOnce sync.Once
+	contextBasedRestrictionsClient     *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
+	contextBasedRestrictionsClientErr  error
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.appidOnce.Do(session.configureAppIDAPI)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.catalogManagementClientOnce.Do(session.configureCatalogManagementV1)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.accountOnce.Do(sess.configureBluemixAcccountAPI)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.accountV1Once.Do(sess.configureBluemixAcccountv1API)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
-	return sess.session.BluemixSession, sess.bluemixSessionErr
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
+	return sess.authenticatedSession()
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
+	sess.bmxUserOnce.Do(sess.configureBluemixUserDetails)
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.csOnce.Do(sess.configureContainerAPI)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.csv2Once.Do(sess.configureVpcContainerAPI)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.containerRegistryClientOnce.Do(session.configureContainerRegistryV1)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.schematicsClientOnce.Do(sess.configureSchematicsV1)
 	return sess.schematicsClient, sess.schematicsClientErr
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.functionOnce.Do(sess.configureFunctionClient)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.globalSearchOnce.Do(sess.configureGlobalSearchAPI)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.globalTaggingOnce.Do(sess.configureGlobalTaggingAPI)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.globalTaggingOnceV1.Do(sess.configureGlobalTaggingAPIv1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.hpcsEndpointOnce.Do(sess.configureHpcsEndpointAPI)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.userManagementOnce.Do(sess.configureUserManagementAPI)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.iamPolicyManagementOnce.Do(sess.configureIAMPolicyManagementV1API)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.iamAccessGroupsOnce.Do(sess.configureIAMAccessGroupsV2)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.ibmCloudShellClientOnce.Do(session.configureIBMCloudShellV1)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.icdOnce.Do(sess.configureICDAPI)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.cloudDatabasesClientOnce.Do(session.configureCloudDatabasesV5)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.cfOnce.Do(sess.configureMccpAPI)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.resourceCatalogConfigOnce.Do(sess.configureResourceCatalogAPI)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.resourceManagementConfigOncev2.Do(sess.configureResourceManagementAPIv2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.resourceControllerConfigOnce.Do(sess.configureResourceControllerAPI)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.resourceControllerConfigOncev2.Do(sess.configureResourceControllerAPIV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
+	if sess.session.SoftLayerSession != nil && sess.session.SoftLayerSession.IAMToken != "" {
+		// The classic session reuses the IBM Cloud IAM token, make sure it is fresh
+		sess.authenticatedSession()
+	}
 	return sess.session.SoftLayerSession
 }
 
+// DefaultTags returns the tags of the provider default_tags argument
+func (sess *clientSession) DefaultTags() []string {
+	return sess.config.DefaultTags
+}
+
+// IgnoreTagPrefixes returns the key prefixes of the provider ignore_tags block
+func (sess *clientSession) IgnoreTagPrefixes() []string {
+	return sess.config.IgnoreTagPrefixes
+}
+
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.certManagementOnce.Do(sess.configureCertificateManagerAPI)
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
-//apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+// apigatewayAPI provides API Gateway APIs
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.apigatewayOnce.Do(sess.configureAPIGateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.pushServiceClientOnce.Do(session.configurePushServiceV1)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.eventNotificationsApiClientOnce.Do(session.configureEventNotificationsApiV1)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.appConfigurationClientOnce.Do(session.configureAppConfigurationV1)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.kpOnce.Do(sess.configureKeyProtectAPI)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.kmsOnce.Do(sess.configureKeyManagementAPI)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -758,53 +921,62 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.config.retryPolicy().Transport(DefaultTransport()))
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return kpClient, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.vpcOnce.Do(sess.configureVpcV1API)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.directlinkOnce.Do(sess.configureDirectlinkV1API)
 	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.dlProviderOnce.Do(sess.configureDirectlinkProviderV2API)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.cosConfigOnce.Do(sess.configureCosConfigV1API)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.transitgatewayOnce.Do(sess.configureTransitGatewayV1API)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.ibmpiConfigOnce.Do(sess.configureIBMPISession)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.pDNSOnce.Do(sess.configurePrivateDNSClientSession)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.functionIAMNamespaceOnce.Do(sess.configureFunctionIAMNamespaceAPI)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.cisZonesOnce.Do(sess.configureCisZonesV1ClientSession)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -812,7 +984,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.cisDNSOnce.Do(sess.configureCisDNSRecordClientSession)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -820,7 +993,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.cisDNSBulkOnce.Do(sess.configureCisDNSRecordBulkClientSession)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -828,7 +1002,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.cisGLBPoolOnce.Do(sess.configureCisGLBPoolClientSession)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -836,7 +1011,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.cisGLBOnce.Do(sess.configureCisGLBClientSession)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -844,7 +1020,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.cisGLBHealthCheckOnce.Do(sess.configureCisGLBHealthCheckClientSession)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -852,7 +1029,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.cisRLOnce.Do(sess.configureCisRLClientSession)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -860,7 +1038,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.cisIPOnce.Do(sess.configureCisIPClientSession)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -868,7 +1047,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.cisPageRuleOnce.Do(sess.configureCisPageRuleClientSession)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -876,7 +1056,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.cisEdgeFunctionOnce.Do(sess.configureCisEdgeFunctionClientSession)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -884,7 +1065,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.cisSSLOnce.Do(sess.configureCisSSLClientSession)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -892,7 +1074,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.cisWAFPackageOnce.Do(sess.configureCisWAFPackageClientSession)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -900,7 +1083,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.cisDomainSettingsOnce.Do(sess.configureCisDomainSettingsClientSession)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -908,7 +1092,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.cisAlertsOnce.Do(sess.configureCisAlertsSession)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -916,7 +1101,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.cisRoutingOnce.Do(sess.configureCisRoutingClientSession)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -924,7 +1110,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.cisWAFGroupOnce.Do(sess.configureCisWAFGroupClientSession)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -932,7 +1119,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.cisCacheOnce.Do(sess.configureCisCacheClientSession)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -940,7 +1128,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.cisCustomPageOnce.Do(sess.configureCisCustomPageClientSession)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -948,7 +1137,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.cisAccessRuleOnce.Do(sess.configureCisAccessRuleClientSession)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -956,7 +1146,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.cisUARuleOnce.Do(sess.configureCisUARuleClientSession)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -964,7 +1155,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.cisLockdownOnce.Do(sess.configureCisLockdownClientSession)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -972,7 +1164,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.cisRangeAppOnce.Do(sess.configureCisRangeAppClientSession)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -980,7 +1173,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.cisWAFRuleOnce.Do(sess.configureCisWAFRuleClientSession)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -988,43 +1182,51 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.iamIdentityOnce.Do(sess.configureIAMIdentityV1API)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.resourceManagerOnce.Do(sess.configureResourceManagerV2API)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.enterpriseManagementClientOnce.Do(session.configureEnterpriseManagementV1)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.resourceControllerOnce.Do(sess.configureResourceControllerV2API)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // SecretsManager Session
-func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+func (session *clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+	session.secretsManagerClientOnce.Do(session.configureSecretsManagerV1)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.satelliteLinkClientOnce.Do(session.configureSatellitLinkClientSession)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.satelliteClientOnce.Do(sess.configureSatelliteClientSession)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.cisLogpushJobsOnce.Do(sess.configureCisLogpushJobsSession)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1032,7 +1234,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.cisWebhooksOnce.Do(sess.configureCisWebhookSession)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1040,7 +1243,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.cisFiltersOnce.Do(sess.configureCisFiltersSession)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1048,7 +1252,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.cisFirewallRulesOnce.Do(sess.configureCisFirewallRulesSession)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1056,41 +1261,48 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+func (session *clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+	session.atrackerClientOnce.Do(session.configureAtrackerV1)
 	return session.atrackerClient, session.atrackerClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.esSchemaRegistryOnce.Do(session.configureESschemaRegistrySession)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
 // Security and Compliance center Findings API
-func (session clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
+func (session *clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
+	session.findingsClientOnce.Do(session.configureFindingsV1)
 	if session.findingsClientErr != nil {
 		return session.findingsClient, session.findingsClientErr
 	}
 	return session.findingsClient.Clone(), nil
 }
 
-//Security and Compliance center Admin API
-func (session clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+// Security and Compliance center Admin API
+func (session *clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+	session.adminServiceApiClientOnce.Do(session.configureAdminServiceApiV1)
 	return session.adminServiceApiClient, session.adminServiceApiClientErr
 }
 
-func (session clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+func (session *clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+	session.configServiceApiClientOnce.Do(session.configureConfigurationGovernanceV1)
 	return session.configServiceApiClient, session.configServiceApiClientErr
 }
 
 // Security and Compliance center Posture Management
-func (session clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+func (session *clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+	session.postureManagementClientOnce.Do(session.configurePostureManagementV1)
 	if session.postureManagementClientErr != nil {
 		return session.postureManagementClient, session.postureManagementClientErr
 	}
 	return session.postureManagementClient.Clone(), nil
 }
 
-//Security and Compliance center Posture Management v2
-func (session clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+// Security and Compliance center Posture Management v2
+func (session *clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+	session.postureManagementClientOncev2.Do(session.configurePostureManagementV2)
 	if session.postureManagementClientErrv2 != nil {
 		return session.postureManagementClientv2, session.postureManagementClientErrv2
 	}
@@ -1098,231 +1310,374 @@ func (session clientSession) PostureManagementV2() (*posturemanagementv2.Posture
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.contextBasedRestrictionsClientOnce.Do(session.configureContextBasedRestrictionsV1)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// ClientSession configures and returns a ClientSession. Service clients are
+// not built here, each one is configured the first time its accessor is used.
 func (c *Config) ClientSession() (interface{}, error) {
+	var fileMap map[string]interface{}
+	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
+		var diags diag.Diagnostics
+		fileMap, diags = ReadEndpointsFile(f)
+		if diags.HasError() {
+			return nil, diagnosticsError(diags)
+		}
+		for _, d := range diags {
+			log.Printf("[WARN] %s: %s", d.Summary, d.Detail)
+		}
+	}
+
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
+	session := &clientSession{
 		session: sess,
+		config:  c,
+		fileMap: fileMap,
+	}
+	if c.computeResourceAuth() {
+		if err := session.configureComputeResourceAuth(); err != nil {
+			return nil, err
+		}
 	}
 
 	if sess.BluemixSession == nil {
 		//Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
-		session.bluemixSessionErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.csv2ConfigErr = errEmptyBluemixCredentials
-		session.containerRegistryClientErr = errEmptyBluemixCredentials
-		session.kpErr = errEmptyBluemixCredentials
-		session.pushServiceClientErr = errEmptyBluemixCredentials
-		session.appConfigurationClientErr = errEmptyBluemixCredentials
-		session.kmsErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
-		session.cisConfigErr = errEmptyBluemixCredentials
-		session.functionConfigErr = errEmptyBluemixCredentials
-		session.globalSearchConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErrV1 = errEmptyBluemixCredentials
-		session.hpcsEndpointErr = errEmptyBluemixCredentials
-		session.iamAccessGroupsErr = errEmptyBluemixCredentials
-		session.icdConfigErr = errEmptyBluemixCredentials
-		session.resourceCatalogConfigErr = errEmptyBluemixCredentials
-		session.resourceManagerErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErrv2 = errEmptyBluemixCredentials
-		session.resourceControllerConfigErr = errEmptyBluemixCredentials
-		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
-		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
-		session.resourceControllerErr = errEmptyBluemixCredentials
-		session.catalogManagementClientErr = errEmptyBluemixCredentials
-		session.ibmpiConfigErr = errEmptyBluemixCredentials
-		session.userManagementErr = errEmptyBluemixCredentials
-		session.certManagementErr = errEmptyBluemixCredentials
-		session.vpcErr = errEmptyBluemixCredentials
-		session.apigatewayErr = errEmptyBluemixCredentials
-		session.pDNSErr = errEmptyBluemixCredentials
-		session.bmxUserFetchErr = errEmptyBluemixCredentials
-		session.directlinkErr = errEmptyBluemixCredentials
-		session.dlProviderErr = errEmptyBluemixCredentials
-		session.cosConfigErr = errEmptyBluemixCredentials
-		session.transitgatewayErr = errEmptyBluemixCredentials
-		session.functionIAMNamespaceErr = errEmptyBluemixCredentials
-		session.cisDNSErr = errEmptyBluemixCredentials
-		session.cisAlertsErr = errEmptyBluemixCredentials
-		session.cisDNSBulkErr = errEmptyBluemixCredentials
-		session.cisGLBPoolErr = errEmptyBluemixCredentials
-		session.cisGLBErr = errEmptyBluemixCredentials
-		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
-		session.cisIPErr = errEmptyBluemixCredentials
-		session.cisZonesErr = errEmptyBluemixCredentials
-		session.cisRLErr = errEmptyBluemixCredentials
-		session.cisPageRuleErr = errEmptyBluemixCredentials
-		session.cisEdgeFunctionErr = errEmptyBluemixCredentials
-		session.cisSSLErr = errEmptyBluemixCredentials
-		session.cisWAFPackageErr = errEmptyBluemixCredentials
-		session.cisDomainSettingsErr = errEmptyBluemixCredentials
-		session.cisRoutingErr = errEmptyBluemixCredentials
-		session.cisWAFGroupErr = errEmptyBluemixCredentials
-		session.cisCacheErr = errEmptyBluemixCredentials
-		session.cisCustomPageErr = errEmptyBluemixCredentials
-		session.cisAccessRuleErr = errEmptyBluemixCredentials
-		session.cisUARuleErr = errEmptyBluemixCredentials
-		session.cisLockdownErr = errEmptyBluemixCredentials
-		session.cisRangeAppErr = errEmptyBluemixCredentials
-		session.cisWAFRuleErr = errEmptyBluemixCredentials
-		session.iamIdentityErr = errEmptyBluemixCredentials
-		session.secretsManagerClientErr = errEmptyBluemixCredentials
-		session.cisFiltersErr = errEmptyBluemixCredentials
-		session.cisWebhooksErr = errEmptyBluemixCredentials
-		session.cisLogpushJobsErr = errEmptyBluemixCredentials
-		session.schematicsClientErr = errEmptyBluemixCredentials
-		session.satelliteClientErr = errEmptyBluemixCredentials
-		session.iamPolicyManagementErr = errEmptyBluemixCredentials
-		session.satelliteLinkClientErr = errEmptyBluemixCredentials
-		session.esSchemaRegistryErr = errEmptyBluemixCredentials
-		session.contextBasedRestrictionsClientErr = errEmptyBluemixCredentials
-		session.postureManagementClientErr = errEmptyBluemixCredentials
-		session.postureManagementClientErrv2 = errEmptyBluemixCredentials
-		session.configServiceApiClientErr = errEmptyBluemixCredentials
-
 		return session, nil
 	}
+	BluemixRegion = sess.BluemixSession.Config.Region
 
-	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
-				err = authenticateAPIKey(sess.BluemixSession)
-			}
-			if err != nil {
-				session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-			}
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+	return session, nil
+}
+
+// computeResourceAuth reports whether the provider authenticates with a
+// compute resource token, the API key and the IAM token take precedence.
+func (c *Config) computeResourceAuth() bool {
+	if c.BluemixAPIKey != "" || c.IAMToken != "" {
+		return false
+	}
+	return c.IAMCRTokenFile != "" || c.IAMUseInstanceMetadata
+}
+
+// configureComputeResourceAuth sets up the authenticator of the trusted
+// profile and makes the HTTP client of the Bluemix session swap the tokens it
+// has refreshed.
+func (session *clientSession) configureComputeResourceAuth() error {
+	c := session.config
+	authenticator := &ComputeResourceAuthenticator{
+		CRTokenFile: c.IAMCRTokenFile,
+		ProfileID:   c.IAMTrustedProfileID,
+		URL:         EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, session.iamURL()),
+		Client:      c.retryPolicy().HTTPClient(c.BluemixTimeout),
+	}
+	if c.IAMCRTokenFile == "" {
+		authenticator.InstanceMetadataURL = session.serviceEndpoint("IBMCLOUD_INSTANCE_METADATA_API_ENDPOINT", DefaultInstanceMetadataURL)
+	}
+	if err := authenticator.Validate(); err != nil {
+		return err
+	}
+	session.crAuthenticator = authenticator
+	if bmxSess := session.session.BluemixSession; bmxSess != nil {
+		client := bmxSess.Config.HTTPClient
+		client.Transport = authenticator.Transport(client.Transport)
+	}
+	return nil
+}
+
+// NewOfflineClientSession returns a session for the unit tests running against
+// local fakes of the IBM Cloud APIs. The go-sdk-core based clients send their
+// requests to the endpoints of c.Endpoints without authenticating, and the
+// user details are those of a user of the given account. The clients needing
+// a Bluemix session fail with an error.
+func NewOfflineClientSession(c *Config, accountID string) ClientSession {
+	session := &clientSession{
+		session: &Session{},
+		config:  c,
+	}
+	session.authenticatorOnce.Do(func() {
+		session.authenticator = &core.NoAuthAuthenticator{}
+	})
+	session.bmxUserOnce.Do(func() {
+		session.bmxUserDetails = &UserConfig{
+			UserID:      "IBMid-offline",
+			UserEmail:   "user@example.com",
+			UserAccount: accountID,
+			CloudName:   "bluemix",
+			cloudType:   "public",
+			generation:  2,
 		}
-		err = authenticateCF(sess.BluemixSession)
+	})
+	return session
+}
+
+// authenticatedSession returns the Bluemix session once IAM authentication
+// (or the refresh of a user supplied token) has been done.
+func (sess *clientSession) authenticatedSession() (*bxsession.Session, error) {
+	if sess.session.BluemixSession == nil {
+		return nil, errEmptyBluemixCredentials
+	}
+	sess.authOnce.Do(sess.authenticate)
+	return sess.session.BluemixSession, sess.authErr
+}
+
+func (sess *clientSession) authenticate() {
+	c := sess.config
+	bmxSess := sess.session.BluemixSession
+
+	if sess.crAuthenticator != nil {
+		token, err := sess.crAuthenticator.Token()
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying CF Authentication %d", count)
-				err = authenticateCF(sess.BluemixSession)
-			}
-			if err != nil {
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-			}
+			sess.authErr = err
+			return
 		}
+		bmxSess.Config.IAMAccessToken = "Bearer " + token
+		return
 	}
 
-	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
-		err := RefreshToken(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
-				err = RefreshToken(sess.BluemixSession)
-			}
-			if err != nil {
-				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
-			}
+	// Transient failures are retried by the HTTP client of the session, see
+	// RetryPolicy
+	if bmxSess.Config.BluemixAPIKey != "" {
+		if err := authenticateAPIKey(bmxSess); err != nil {
+			sess.iamAuthErr = err
 		}
+	}
+
+	if c.IAMTrustedProfileID == "" && bmxSess.Config.IAMAccessToken != "" && bmxSess.Config.BluemixAPIKey == "" {
+		if err := RefreshToken(bmxSess); err != nil {
+			sess.authErr = fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
+			return
+		}
+	}
 
+	if sess.session.SoftLayerSession != nil && sess.session.SoftLayerSession.IAMToken != "" {
+		sess.session.SoftLayerSession.IAMToken = bmxSess.Config.IAMAccessToken
+		sess.session.SoftLayerSession.IAMRefreshToken = bmxSess.Config.IAMRefreshToken
 	}
-	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
+}
+
+// cfAuthenticatedSession additionally fetches the UAA tokens used by the Cloud
+// Foundry and Cloud Functions APIs.
+func (sess *clientSession) cfAuthenticatedSession() (*bxsession.Session, error) {
+	bmxSess, err := sess.authenticatedSession()
 	if err != nil {
-		session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
+		return nil, err
 	}
-	session.bmxUserDetails = userConfig
+	sess.cfAuthOnce.Do(func() {
+		if bmxSess.Config.BluemixAPIKey == "" {
+			return
+		}
+		if err := authenticateCF(bmxSess); err != nil {
+			log.Printf("[WARN] Error occured while fetching auth key for function: %q", err)
+		}
+	})
+	return bmxSess, nil
+}
 
-	if sess.SoftLayerSession != nil && sess.SoftLayerSession.IAMToken != "" {
-		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
-		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
+// iamAuthenticator returns the authenticator shared by the IBM go-sdk-core
+// based clients.
+func (sess *clientSession) iamAuthenticator() (core.Authenticator, error) {
+	sess.authenticatorOnce.Do(sess.configureAuthenticator)
+	return sess.authenticator, sess.authenticatorErr
+}
+
+func (sess *clientSession) configureAuthenticator() {
+	c := sess.config
+	bmxSess, err := sess.authenticatedSession()
+	if err != nil {
+		sess.authenticatorErr = err
+		return
 	}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+	if sess.crAuthenticator != nil {
+		sess.authenticator = sess.crAuthenticator
+		return
+	}
 
-	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
+	iamURL := sess.iamURL()
+	if c.BluemixAPIKey != "" || bmxSess.Config.IAMRefreshToken != "" {
+		if c.BluemixAPIKey != "" {
+			sess.authenticator = &core.IamAuthenticator{
+				ApiKey: c.BluemixAPIKey,
+				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client: c.retryPolicy().HTTPClient(c.BluemixTimeout),
+			}
+		} else {
+			// Construct the IamAuthenticator with the IAM refresh token.
+			sess.authenticator = &core.IamAuthenticator{
+				RefreshToken: bmxSess.Config.IAMRefreshToken,
+				ClientId:     "bx",
+				ClientSecret: "bx",
+				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client:       c.retryPolicy().HTTPClient(c.BluemixTimeout),
+			}
 		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
+	} else if strings.HasPrefix(bmxSess.Config.IAMAccessToken, "Bearer") {
+		sess.authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: bmxSess.Config.IAMAccessToken[7:],
 		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+	} else {
+		sess.authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: bmxSess.Config.IAMAccessToken,
 		}
 	}
-	accv1API, err := accountv1.New(sess.BluemixSession)
+}
+
+// iamURL returns the IAM endpoint for the configured region and visibility.
+func (sess *clientSession) iamURL() string {
+	c := sess.config
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	iamURL = sess.serviceEndpoint("IBMCLOUD_IAM_API_ENDPOINT", iamURL)
+	return iamURL
+}
+
+// userAccount returns the account of the authenticated user, or an empty
+// string when the user details could not be fetched.
+func (sess *clientSession) userAccount() string {
+	if userConfig, _ := sess.BluemixUserDetails(); userConfig != nil {
+		return userConfig.UserAccount
+	}
+	return ""
+}
+
+func (sess *clientSession) configureBluemixUserDetails() {
+	c := sess.config
+	bmxSess, err := sess.authenticatedSession()
+	if err != nil {
+		sess.bmxUserFetchErr = err
+		return
+	}
+	if sess.iamAuthErr != nil {
+		sess.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", sess.iamAuthErr)
+	}
+	userConfig, err := fetchUserDetails(bmxSess, c.retryPolicy())
+	if err != nil {
+		sess.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
+	}
+	sess.bmxUserDetails = userConfig
+}
+
+func (session *clientSession) configureFunctionClient() {
+	bmxSess, err := session.cfAuthenticatedSession()
+	if err != nil {
+		session.functionConfigErr = err
+		return
+	}
+	session.functionClient, session.functionConfigErr = FunctionClient(bmxSess.Config)
+}
+
+func (session *clientSession) configureBluemixAcccountv1API() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.accountV1ConfigErr = err
+		return
+	}
+	accv1API, err := accountv1.New(bmxSess)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
 
-	accAPI, err := accountv2.New(sess.BluemixSession)
+func (session *clientSession) configureBluemixAcccountAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.accountConfigErr = err
+		return
+	}
+	accAPI, err := accountv2.New(bmxSess)
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
 
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
+func (session *clientSession) configureMccpAPI() {
+	bmxSess, err := session.cfAuthenticatedSession()
+	if err != nil {
+		session.cfConfigErr = err
+		return
+	}
+	cfAPI, err := mccpv2.New(bmxSess)
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
 
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
+func (session *clientSession) configureContainerAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.csConfigErr = err
+		return
+	}
+	clusterAPI, err := containerv1.New(bmxSess)
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
 
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
+func (session *clientSession) configureVpcContainerAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.csv2ConfigErr = err
+		return
+	}
+	v2clusterAPI, err := containerv2.New(bmxSess)
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
+func (session *clientSession) configureHpcsEndpointAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.hpcsEndpointErr = err
+		return
+	}
+	hpcsAPI, err := hpcs.New(bmxSess)
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
 
+func (session *clientSession) configureKeyProtectAPI() {
+	c := session.config
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.kpErr = err
+		return
+	}
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
+	kpurl = session.serviceEndpoint("IBMCLOUD_KP_API_ENDPOINT", kpurl)
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
 			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+			APIKey:  bmxSess.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
@@ -1330,42 +1685,37 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		options = kp.ClientConfig{
 			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
+			Authorization: bmxSess.Config.IAMAccessToken,
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, c.retryPolicy().Transport(DefaultTransport()))
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+// KEY MANAGEMENT Service
+func (session *clientSession) configureKeyManagementAPI() {
+	c := session.config
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.kmsErr = err
+		return
 	}
-
-	// KEY MANAGEMENT Service
+	iamURL := session.iamURL()
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
+	kmsurl = session.serviceEndpoint("IBMCLOUD_KP_API_ENDPOINT", kmsurl)
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
 			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
+			APIKey:  bmxSess.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
 			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
@@ -1374,53 +1724,32 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		kmsOptions = kp.ClientConfig{
 			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
+			Authorization: bmxSess.Config.IAMAccessToken,
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
 			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, c.retryPolicy().Transport(DefaultTransport()))
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
+// APPID Service
+func (session *clientSession) configureAppIDAPI() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.appidErr = err
+		return
 	}
-
-	// APPID Service
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
+	appIDEndpoint = session.serviceEndpoint("IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", appIDEndpoint)
 	appIDClientOptions := &appid.AppIDManagementV4Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
@@ -1430,21 +1759,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(appIDClient.Service)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
 
-	// Construct an "options" struct for creating Context Based Restrictions service client.
+// Construct an "options" struct for creating Context Based Restrictions service client.
+func (session *clientSession) configureContextBasedRestrictionsV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.contextBasedRestrictionsClientErr = err
+		return
+	}
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("Context Based Restrictions Service API does not support private endpoints") //return this error if private endpoints are not supported
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
+	cbrURL = session.serviceEndpoint("IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", cbrURL)
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
@@ -1454,7 +1789,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1462,15 +1797,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
 
-	// CATALOG MANAGEMENT Service
+// CATALOG MANAGEMENT Service
+func (session *clientSession) configureCatalogManagementV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.catalogManagementClientErr = err
+		return
+	}
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
+	catalogManagementURL = session.serviceEndpoint("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", catalogManagementURL)
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
 		Authenticator: authenticator,
@@ -1482,14 +1823,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ATRACKER Service
+// ATRACKER Service
+func (session *clientSession) configureAtrackerV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.atrackerClientErr = err
+		return
+	}
 	var atrackerClientURL string
 	atrackerClientURL, err = atrackerv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1504,9 +1853,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			}
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientURL)
-	}
+	atrackerClientURL = session.serviceEndpoint("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientURL)
 	atrackerClientOptions := &atrackerv1.AtrackerV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
@@ -1518,14 +1865,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
 		// Enable retries for API calls
-		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.atrackerClient.Service)
 		// Add custom header for analytics
 		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SCC FINDINGS Service
+// SCC FINDINGS Service
+func (session *clientSession) configureFindingsV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.findingsClientErr = err
+		return
+	}
 	var findingsClientURL string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
 		findingsClientURL, err = findingsv1.GetServiceURLForRegion(c.Region)
@@ -1535,13 +1890,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.findingsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Security Insights Findings API service: `%v` visibility not supported", c.Visibility)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		findingsClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", c.Region, findingsClientURL)
-	}
+	findingsClientURL = session.serviceEndpoint("IBMCLOUD_SCC_FINDINGS_API_ENDPOINT", findingsClientURL)
 	findingsClientOptions := &findingsv1.FindingsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_FINDINGS_API_ENDPOINT"}, findingsClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
+		AccountID:     core.StringPtr(session.userAccount()),
 	}
 	// Construct the service client.
 	session.findingsClient, err = findingsv1.NewFindingsV1(findingsClientOptions)
@@ -1550,14 +1903,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.findingsClient != nil && session.findingsClient.Service != nil {
 		// Enable retries for API calls
-		session.findingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.findingsClient.Service)
 		// Add custom header for analytics
 		session.findingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SCC ADMIN Service
+// SCC ADMIN Service
+func (session *clientSession) configureAdminServiceApiV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.adminServiceApiClientErr = err
+		return
+	}
 	var adminServiceApiClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion("private." + c.Region)
@@ -1579,7 +1940,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.adminServiceApiClient.Service)
 		// Add custom header for analytics
 		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1587,8 +1948,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
 	}
+}
 
-	// SCHEMATICS Service
+// SCHEMATICS Service
+func (session *clientSession) configureSchematicsV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.schematicsClientErr = err
+		return
+	}
 	schematicsEndpoint := "https://schematics.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1599,9 +1968,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			schematicsEndpoint = "https://schematics.cloud.ibm.com"
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
+	schematicsEndpoint = session.serviceEndpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT", schematicsEndpoint)
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
@@ -1613,21 +1980,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(schematicsClient.Service)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
 
-	// VPC Service
+// VPC Service
+func (session *clientSession) configureVpcV1API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.vpcErr = err
+		return
+	}
 	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
+	vpcurl = session.serviceEndpoint("IBMCLOUD_IS_NG_API_ENDPOINT", vpcurl)
 	vpcoptions := &vpc.VpcV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
 		Authenticator: authenticator,
@@ -1637,21 +2010,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(vpcclient.Service)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcAPI = vpcclient
+}
 
-	// PUSH NOTIFICATIONS Service
+// PUSH NOTIFICATIONS Service
+func (session *clientSession) configurePushServiceV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.pushServiceClientErr = err
+		return
+	}
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
+	pnurl = session.serviceEndpoint("IBMCLOUD_PUSH_API_ENDPOINT", pnurl)
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
 		Authenticator: authenticator,
@@ -1662,20 +2041,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(pnclient.Service)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
-	// event notifications
+}
+
+// event notifications
+func (session *clientSession) configureEventNotificationsApiV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.eventNotificationsApiClientErr = err
+		return
+	}
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	if c.Visibility == "private" {
 		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
+	enurl = session.serviceEndpoint("IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", enurl)
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
@@ -1688,13 +2074,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// APP CONFIGURATION Service
+// APP CONFIGURATION Service
+func (session *clientSession) configureAppConfigurationV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.appConfigurationClientErr = err
+		return
+	}
 	if c.Visibility == "private" {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] App Configuration Service API doesnot support private endpoints")
 	}
@@ -1704,13 +2098,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
 
-	// CONTAINER REGISTRY Service
+// CONTAINER REGISTRY Service
+func (session *clientSession) configureContainerRegistryV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.containerRegistryClientErr = err
+		return
+	}
 	// Construct an "options" struct for creating the service client.
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1722,13 +2124,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
+	containerRegistryClientURL = session.serviceEndpoint("IBMCLOUD_CR_API_ENDPOINT", containerRegistryClientURL)
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
+		Account:       core.StringPtr(session.userAccount()),
 	}
 	// Construct the service client.
 	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
@@ -1737,18 +2137,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// OBJECT STORAGE Service
-	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
+// OBJECT STORAGE Service
+func (session *clientSession) configureCosConfigV1API() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cosConfigErr = err
+		return
 	}
+	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
+	cosconfigurl = session.serviceEndpoint("IBMCLOUD_COS_CONFIG_ENDPOINT", cosconfigurl)
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
@@ -1757,21 +2162,47 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
+	if cosconfigclient != nil && cosconfigclient.Service != nil {
+		cosconfigclient.Service.SetHTTPClient(session.retryHTTPClient(cosconfigclient.Service.Client))
+	}
 	session.cosConfigAPI = cosconfigclient
+}
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+func (session *clientSession) configureGlobalSearchAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.globalSearchConfigErr = err
+		return
+	}
+	globalSearchAPI, err := globalsearchv2.New(bmxSess)
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+}
+
+// Global Tagging Bluemix-go
+func (session *clientSession) configureGlobalTaggingAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.globalTaggingConfigErr = err
+		return
+	}
+	globalTaggingAPI, err := globaltaggingv3.New(bmxSess)
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
 
-	// GLOBAL TAGGING Service
+// GLOBAL TAGGING Service
+func (session *clientSession) configureGlobalTaggingAPIv1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.globalTaggingConfigErrV1 = err
+		return
+	}
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -1782,9 +2213,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
+	globalTaggingEndpoint = session.serviceEndpoint("IBMCLOUD_GT_API_ENDPOINT", globalTaggingEndpoint)
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
 		Authenticator: authenticator,
@@ -1795,18 +2224,33 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	icdAPI, err := icdv4.New(sess.BluemixSession)
+func (session *clientSession) configureICDAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.icdConfigErr = err
+		return
+	}
+	icdAPI, err := icdv4.New(bmxSess)
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
 
+func (session *clientSession) configureCloudDatabasesV5() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cloudDatabasesClientErr = err
+		return
+	}
 	var cloudDatabasesEndpoint string
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1825,7 +2269,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cloudDatabasesClient.Service)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1833,57 +2277,111 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+func (session *clientSession) configureResourceCatalogAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.resourceCatalogConfigErr = err
+		return
+	}
+	resourceCatalogAPI, err := catalog.New(bmxSess)
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+func (session *clientSession) configureResourceManagementAPIv2() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.resourceManagementConfigErrv2 = err
+		return
+	}
+	resourceManagementAPIv2, err := managementv2.New(bmxSess)
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
+func (session *clientSession) configureResourceControllerAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.resourceControllerConfigErr = err
+		return
+	}
+	resourceControllerAPI, err := controller.New(bmxSess)
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
+func (session *clientSession) configureResourceControllerAPIV2() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.resourceControllerConfigErrv2 = err
+		return
+	}
+	ResourceControllerAPIv2, err := controllerv2.New(bmxSess)
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+func (session *clientSession) configureUserManagementAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.userManagementErr = err
+		return
+	}
+	userManagementAPI, err := usermanagementv2.New(bmxSess)
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
 
-	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
+func (session *clientSession) configureCertificateManagerAPI() {
+	bmxSess, err := session.authenticatedSession()
+	if err != nil {
+		session.certManagementErr = err
+		return
+	}
+	certManagementAPI, err := certificatemanager.New(bmxSess)
 	if err != nil {
 		session.certManagementErr = fmt.Errorf("[ERROR] Error occured while configuring Certificate manager service: %q", err)
 	}
 	session.certManagementAPI = certManagementAPI
+}
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
+func (session *clientSession) configureFunctionIAMNamespaceAPI() {
+	bmxSess, err := session.cfAuthenticatedSession()
+	if err != nil {
+		session.functionIAMNamespaceErr = err
+		return
+	}
+	namespaceFunction, err := functions.New(bmxSess)
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
 
-	//  API GATEWAY service
+// API GATEWAY service
+func (session *clientSession) configureAPIGateway() {
+	c := session.config
+	if _, err := session.authenticatedSession(); err != nil {
+		session.apigatewayErr = err
+		return
+	}
 	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
-	}
+	apicurl = session.serviceEndpoint("IBMCLOUD_API_GATEWAY_ENDPOINT", apicurl)
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
@@ -1892,32 +2390,54 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
 	}
+	if apigatewayAPI != nil && apigatewayAPI.Service != nil {
+		apigatewayAPI.Service.SetHTTPClient(session.retryHTTPClient(apigatewayAPI.Service.Client))
+	}
 	session.apigatewayAPI = apigatewayAPI
+}
 
-	// POWER SYSTEMS Service
+// POWER SYSTEMS Service
+func (session *clientSession) configureIBMPISession() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.ibmpiConfigErr = err
+		return
+	}
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
 		Debug:         os.Getenv("TF_LOG") != "",
 		Region:        c.Region,
 		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
+		UserAccount:   session.userAccount(),
 		Zone:          c.Zone,
 	}
 	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
 	if err != nil {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
 	}
+	if ibmpisession != nil && ibmpisession.Power != nil {
+		if rt, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+			rt.Transport = c.retryPolicy().Transport(rt.Transport)
+		}
+	}
 	session.ibmpiSession = ibmpisession
+}
 
-	// PRIVATE DNS Service
+// PRIVATE DNS Service
+func (session *clientSession) configurePrivateDNSClientSession() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.pDNSErr = err
+		return
+	}
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
+	pdnsURL = session.serviceEndpoint("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", pdnsURL)
 	dnsOptions := &dns.DnsSvcsV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
 		Authenticator: authenticator,
@@ -1927,21 +2447,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.pDNSClient.Service)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK Service
+// DIRECT LINK Service
+func (session *clientSession) configureDirectlinkV1API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.directlinkErr = err
+		return
+	}
 	ver := time.Now().Format("2006-01-02")
 	dlURL := dl.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
+	dlURL = session.serviceEndpoint("IBMCLOUD_DL_API_ENDPOINT", dlURL)
 	directlinkOptions := &dl.DirectLinkV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
 		Authenticator: authenticator,
@@ -1952,20 +2478,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.directlinkAPI.Service)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK PROVIDER Service
+// DIRECT LINK PROVIDER Service
+func (session *clientSession) configureDirectlinkProviderV2API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.dlProviderErr = err
+		return
+	}
+	ver := time.Now().Format("2006-01-02")
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
+	dlproviderURL = session.serviceEndpoint("IBMCLOUD_DL_PROVIDER_API_ENDPOINT", dlproviderURL)
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
 		Authenticator: authenticator,
@@ -1976,20 +2509,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.dlProviderAPI.Service)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// TRANSIT GATEWAY Service
+// TRANSIT GATEWAY Service
+func (session *clientSession) configureTransitGatewayV1API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.transitgatewayErr = err
+		return
+	}
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
+	tgURL = session.serviceEndpoint("IBMCLOUD_TG_API_ENDPOINT", tgURL)
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
 		Authenticator: authenticator,
@@ -2000,47 +2539,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.transitgatewayAPI.Service)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
-
-	// IBM Network CIS Zones service
+// IBM Network CIS Zones service
+func (session *clientSession) configureCisZonesV1ClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisZonesErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2053,13 +2566,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS DNS Record service
+// IBM Network CIS DNS Record service
+func (session *clientSession) configureCisDNSRecordClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisDNSErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2071,13 +2592,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS DNS Record bulk service
+// IBM Network CIS DNS Record bulk service
+func (session *clientSession) configureCisDNSRecordBulkClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisDNSBulkErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2091,13 +2620,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer pool
+// IBM Network CIS Global load balancer pool
+func (session *clientSession) configureCisGLBPoolClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisGLBPoolErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2111,13 +2648,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer
+// IBM Network CIS Global load balancer
+func (session *clientSession) configureCisGLBClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisGLBErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -2131,13 +2676,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer health check/monitor
+// IBM Network CIS Global load balancer health check/monitor
+func (session *clientSession) configureCisGLBHealthCheckClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisGLBHealthCheckErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2151,13 +2704,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS IP
+// IBM Network CIS IP
+func (session *clientSession) configureCisIPClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisIPErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2168,13 +2729,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Zone Rate Limit
+// IBM Network CIS Zone Rate Limit
+func (session *clientSession) configureCisRLClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisRLErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2188,12 +2757,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Alerts
+}
+
+// IBM Network CIS Alerts
+func (session *clientSession) configureCisAlertsSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisAlertsErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2206,13 +2784,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Page Rules
+// IBM Network CIS Page Rules
+func (session *clientSession) configureCisPageRuleClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisPageRuleErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2226,13 +2812,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Edge Function
+// IBM Network CIS Edge Function
+func (session *clientSession) configureCisEdgeFunctionClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisEdgeFunctionErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2247,13 +2841,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS SSL certificate
+// IBM Network CIS SSL certificate
+func (session *clientSession) configureCisSSLClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisSSLErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2268,13 +2870,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Package
+// IBM Network CIS WAF Package
+func (session *clientSession) configureCisWAFPackageClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisWAFPackageErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2289,13 +2899,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Domain settings
+// IBM Network CIS Domain settings
+func (session *clientSession) configureCisDomainSettingsClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisDomainSettingsErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2310,13 +2928,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Routing
+// IBM Network CIS Routing
+func (session *clientSession) configureCisRoutingClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisRoutingErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2331,13 +2957,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Group
+// IBM Network CIS WAF Group
+func (session *clientSession) configureCisWAFGroupClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisWAFGroupErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2352,13 +2986,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Cache service
+// IBM Network CIS Cache service
+func (session *clientSession) configureCisCacheClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisCacheErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2373,13 +3015,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Custom pages service
+// IBM Network CIS Custom pages service
+func (session *clientSession) configureCisCustomPageClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisCustomPageErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2395,13 +3045,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall Access rule
+// IBM Network CIS Firewall Access rule
+func (session *clientSession) configureCisAccessRuleClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisAccessRuleErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2416,13 +3074,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall User Agent Blocking rule
+// IBM Network CIS Firewall User Agent Blocking rule
+func (session *clientSession) configureCisUARuleClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisUARuleErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2437,13 +3103,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall Lockdown rule
+// IBM Network CIS Firewall Lockdown rule
+func (session *clientSession) configureCisLockdownClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisLockdownErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2458,13 +3132,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Range Application rule
+// IBM Network CIS Range Application rule
+func (session *clientSession) configureCisRangeAppClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisRangeAppErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2479,13 +3161,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Rule Service
+// IBM Network CIS WAF Rule Service
+func (session *clientSession) configureCisWAFRuleClientSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisWAFRuleErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2500,13 +3190,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS LogpushJobs
+// IBM Network CIS LogpushJobs
+func (session *clientSession) configureCisLogpushJobsSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisLogpushJobsErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2521,13 +3219,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Webhooks
+// IBM Network CIS Webhooks
+func (session *clientSession) configureCisWebhookSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisWebhooksErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2540,12 +3246,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Filters
+}
+
+// IBM Network CIS Filters
+func (session *clientSession) configureCisFiltersSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisFiltersErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2557,13 +3272,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall rules
+// IBM Network CIS Firewall rules
+func (session *clientSession) configureCisFirewallRulesSession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.cisFirewallRulesErr = err
+		return
+	}
+	cisEndPoint := session.cisEndpoint()
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2575,13 +3298,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IAM IDENTITY Service
+// IAM IDENTITY Service
+func (session *clientSession) configureIAMIdentityV1API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.iamIdentityErr = err
+		return
+	}
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
 	iamIdenityURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2591,9 +3322,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
+	iamIdenityURL = session.serviceEndpoint("IBMCLOUD_IAM_API_ENDPOINT", iamIdenityURL)
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
@@ -2603,14 +3332,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
-	// IAM POLICY MANAGEMENT Service
+// IAM POLICY MANAGEMENT Service
+func (session *clientSession) configureIAMPolicyManagementV1API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.iamPolicyManagementErr = err
+		return
+	}
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2619,9 +3356,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
+	iamPolicyManagementURL = session.serviceEndpoint("IBMCLOUD_IAM_API_ENDPOINT", iamPolicyManagementURL)
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
@@ -2631,14 +3366,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
-	// IAM ACCESS GROUP
+// IAM ACCESS GROUP
+func (session *clientSession) configureIAMAccessGroupsV2() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.iamAccessGroupsErr = err
+		return
+	}
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2647,9 +3390,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
+	iamAccessGroupsURL = session.serviceEndpoint("IBMCLOUD_IAM_API_ENDPOINT", iamAccessGroupsURL)
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
@@ -2659,14 +3400,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
 
-	// RESOURCE MANAGEMENT Service
+// RESOURCE MANAGEMENT Service
+func (session *clientSession) configureResourceManagerV2API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.resourceManagerErr = err
+		return
+	}
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2683,9 +3432,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rmURL = resourcemanager.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
+	rmURL = session.serviceEndpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", rmURL)
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
@@ -2695,18 +3442,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(resourceManagerClient.Service)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
-	//CLOUD SHELL Service
-	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
+// CLOUD SHELL Service
+func (session *clientSession) configureIBMCloudShellV1() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.ibmCloudShellClientErr = err
+		return
 	}
+	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
+	cloudShellUrl = session.serviceEndpoint("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", cloudShellUrl)
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
@@ -2716,13 +3468,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ENTERPRISE Service
+// ENTERPRISE Service
+func (session *clientSession) configureEnterpriseManagementV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.enterpriseManagementClientErr = err
+		return
+	}
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -2740,9 +3500,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
+	enterpriseURL = session.serviceEndpoint("IBMCLOUD_ENTERPRISE_API_ENDPOINT", enterpriseURL)
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
@@ -2752,14 +3510,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(enterpriseManagementClient.Service)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
-	// RESOURCE CONTROLLER Service
+// RESOURCE CONTROLLER Service
+func (session *clientSession) configureResourceControllerV2API() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.resourceControllerErr = err
+		return
+	}
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2776,9 +3542,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rcURL = resourcecontroller.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
+	rcURL = session.serviceEndpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", rcURL)
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
@@ -2788,14 +3552,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(resourceControllerClient.Service)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
 
-	// SECRETS MANAGER Service
+// SECRETS MANAGER Service
+func (session *clientSession) configureSecretsManagerV1() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.secretsManagerClientErr = err
+		return
+	}
 	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
 		Authenticator: authenticator,
 	}
@@ -2806,21 +3577,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SATELLITE Service
+// SATELLITE Service
+func (session *clientSession) configureSatelliteClientSession() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.satelliteClientErr = err
+		return
+	}
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
+	containerEndpoint = session.serviceEndpoint("IBMCLOUD_SATELLITE_API_ENDPOINT", containerEndpoint)
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
 		Authenticator: authenticator,
@@ -2832,21 +3609,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.satelliteClient.Service)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SATELLITE LINK Service
+// SATELLITE LINK Service
+func (session *clientSession) configureSatellitLinkClientSession() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.satelliteLinkClientErr = err
+		return
+	}
 	// Construct an "options" struct for creating the service client.
 	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
+	satelliteLinkEndpoint = session.serviceEndpoint("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", satelliteLinkEndpoint)
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
 		Authenticator: authenticator,
@@ -2857,13 +3640,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.satelliteLinkClient.Service)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+// EVENT STREAMS SCHEMA REGISTRY Service
+func (session *clientSession) configureESschemaRegistrySession() {
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.esSchemaRegistryErr = err
+		return
+	}
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
 		Authenticator: authenticator,
 	}
@@ -2872,13 +3663,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// Governance Service
+// Governance Service
+func (session *clientSession) configureConfigurationGovernanceV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.configServiceApiClientErr = err
+		return
+	}
 	var configServiceApiClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion("private." + c.Region)
@@ -2898,7 +3697,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.configServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.configServiceApiClient.Service)
 		// Add custom header for analytics
 		session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2906,8 +3705,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.configServiceApiClientErr = fmt.Errorf("Error occurred while configuring Config Service API service: %q", err)
 	}
+}
 
-	//COMPLIANCE Service
+// COMPLIANCE Service
+func (session *clientSession) configurePostureManagementV1() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.postureManagementClientErr = err
+		return
+	}
 	// Construct an "options" struct for creating the service client.
 	var postureManagementClientURL string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
@@ -2918,13 +3725,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		postureManagementClientURL = posturemanagementv1.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURL)
-	}
+	postureManagementClientURL = session.serviceEndpoint("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURL)
 	postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
-		AccountID:     core.StringPtr(userConfig.UserAccount),
+		AccountID:     core.StringPtr(session.userAccount()),
 	}
 
 	// Construct the service client.
@@ -2934,14 +3739,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.postureManagementClient.Service)
 		// Add custom header for analytics
 		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	//COMPLIANCE Service v2 version
+// COMPLIANCE Service v2 version
+func (session *clientSession) configurePostureManagementV2() {
+	c := session.config
+	authenticator, err := session.iamAuthenticator()
+	if err != nil {
+		session.postureManagementClientErrv2 = err
+		return
+	}
 	// Construct an "options" struct for creating the service client.
 	var postureManagementClientURLv2 string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
@@ -2952,9 +3765,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.postureManagementClientErrv2 = fmt.Errorf("[ERROR] Error occurred while configuring Security Posture Management API service:  `%s` region not supported", c.Region)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		postureManagementClientURLv2 = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COMPLIANCE_API_ENDPOINT", c.Region, postureManagementClientURLv2)
-	}
+	postureManagementClientURLv2 = session.serviceEndpoint("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURLv2)
 	postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
@@ -2967,19 +3778,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.postureManagementClientv2.Service)
 		// Add custom header for analytics
 		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-	return session, nil
+// cisEndpoint returns the endpoint shared by all the CIS service clients.
+func (session *clientSession) cisEndpoint() string {
+	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+	cisURL = session.serviceEndpoint("IBMCLOUD_CIS_API_ENDPOINT", cisURL)
+	return EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -2990,15 +3801,20 @@ func CreateVersionDate() *string {
 
 func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
+	retryPolicy := c.retryPolicy()
+	// Retries are done by the HTTP clients, the retry loops of the SDKs are
+	// disabled so that calls aren't retried twice
+	noRetries := 0
 
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint:   c.SoftLayerEndpointURL,
+		Timeout:    c.SoftLayerTimeout,
+		UserName:   c.SoftLayerUserName,
+		APIKey:     c.SoftLayerAPIKey,
+		Debug:      os.Getenv("TF_LOG") != "",
+		Retries:    noRetries,
+		RetryWait:  c.RetryDelay,
+		HTTPClient: retryPolicy.HTTPClient(c.SoftLayerTimeout),
 	}
 
 	if c.IAMToken != "" {
@@ -3017,7 +3833,7 @@ func newSession(c *Config) (*Session, error) {
 	if c.IAMTrustedProfileID == "" && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
-	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
+	if c.IAMTrustedProfileID != "" && c.IAMToken == "" && !c.computeResourceAuth() {
 		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
 	}
 
@@ -3030,10 +3846,11 @@ func newSession(c *Config) (*Session, error) {
 			//Comment out debug mode for v0.12
 			Debug:         os.Getenv("TF_LOG") != "",
 			HTTPTimeout:   c.BluemixTimeout,
+			HTTPClient:    retryPolicy.HTTPClient(c.BluemixTimeout),
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			MaxRetries:    &noRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
@@ -3053,10 +3870,34 @@ func newSession(c *Config) (*Session, error) {
 			//Comment out debug mode for v0.12
 			Debug:         os.Getenv("TF_LOG") != "",
 			HTTPTimeout:   c.BluemixTimeout,
+			HTTPClient:    retryPolicy.HTTPClient(c.BluemixTimeout),
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			MaxRetries:    &noRetries,
+			Visibility:    c.Visibility,
+			EndpointsFile: c.EndpointsFile,
+			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+		}
+		sess, err := bxsession.New(bmxConfig)
+		if err != nil {
+			return nil, err
+		}
+		ibmSession.BluemixSession = sess
+	}
+
+	if c.computeResourceAuth() {
+		log.Println("Configuring IBM Cloud Session with a compute resource token")
+		// The token is set once the trusted profile is assumed, see
+		// ComputeResourceAuthenticator
+		bmxConfig := &bluemix.Config{
+			Debug:         os.Getenv("TF_LOG") != "",
+			HTTPTimeout:   c.BluemixTimeout,
+			HTTPClient:    retryPolicy.HTTPClient(c.BluemixTimeout),
+			Region:        c.Region,
+			ResourceGroup: c.ResourceGroup,
+			RetryDelay:    &c.RetryDelay,
+			MaxRetries:    &noRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
@@ -3074,6 +3915,7 @@ func newSession(c *Config) (*Session, error) {
 func authenticateAPIKey(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -3088,6 +3930,7 @@ func authenticateAPIKey(sess *bxsession.Session) error {
 func authenticateCF(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewUAARepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{http.UserAgent()},
@@ -3099,7 +3942,11 @@ func authenticateCF(sess *bxsession.Session) error {
 	return tokenRefresher.AuthenticateAPIKey(config.BluemixAPIKey)
 }
 
-func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Duration) (*UserConfig, error) {
+func fetchUserDetails(sess *bxsession.Session, policy RetryPolicy) (*UserConfig, error) {
+	return fetchUserDetailsAttempt(sess, policy, 0)
+}
+
+func fetchUserDetailsAttempt(sess *bxsession.Session, policy RetryPolicy, attempt int) (*UserConfig, error) {
 	config := sess.Config
 	user := UserConfig{}
 	var bluemixToken string
@@ -3115,12 +3962,12 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 	})
 	//TODO validate with key
 	if err != nil && !strings.Contains(err.Error(), "key is of invalid type") {
-		if retries > 0 {
+		if attempt+1 < policy.MaxAttempts {
 			if config.BluemixAPIKey != "" {
-				time.Sleep(retryDelay)
-				log.Printf("Retrying authentication for user details %d", retries)
+				time.Sleep(policy.Backoff(attempt, nil))
+				log.Printf("Retrying authentication for user details %d", attempt+1)
 				_ = authenticateAPIKey(sess)
-				return fetchUserDetails(sess, retries-1, retryDelay)
+				return fetchUserDetailsAttempt(sess, policy, attempt+1)
 			}
 		}
 		return &user, err
@@ -3146,6 +3993,7 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 func RefreshToken(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -3166,11 +4014,23 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// fileFallBack looks up the endpoint of key in the endpoints file for the given
+// visibility and region. With the public-and-private visibility the private
+// endpoint is preferred, and the public one is used when the file has none.
 func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
+	val, ok := fileMap[key].(map[string]interface{})
+	if !ok {
+		return defaultValue
+	}
+	visibilities := []string{visibility}
+	if visibility == "public-and-private" {
+		visibilities = append(visibilities, "private", "public")
+	}
+	for _, vis := range visibilities {
+		if v, ok := val[vis].(map[string]interface{}); ok {
+			if r, ok := v[region].(string); ok && r != "" {
+				return r
 			}
 		}
 	}
@@ -3190,29 +4050,6 @@ func DefaultTransport() gohttp.RoundTripper {
 	return transport
 }
 
-func isRetryable(err error) bool {
-	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
-		}
-	}
-
-	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(*net.OpError); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(net.UnknownNetworkError); ok && netErr.Timeout() {
-		return true
-	}
-
-	return false
-}
-
 func ContructEndpoint(subdomain, domain string) string {
 	endpoint := fmt.Sprintf("https://%s.%s", subdomain, domain)
 	return endpoint
@@ -27,7 +27,11 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	httpClient := http.DefaultClient
+	if c.HTTPClient != nil {
+		httpClient = c.HTTPClient
+	}
+	functionsClient, err := whisk.NewClient(httpClient, &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -84,16 +88,7 @@ func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, funct
 
 				err := RefreshToken(sess)
 				if err != nil {
-					for count := sess.Config.MaxRetries; *count >= 0; *count-- {
-						if err == nil || !isRetryable(err) {
-							break
-						}
-						err = RefreshToken(sess)
-					}
-					if err != nil {
-						return nil, err
-					}
-
+					return nil, err
 				}
 				additionalHeaders.Add("Authorization", sess.Config.IAMAccessToken)
 				additionalHeaders.Add("X-Namespace-Id", n.GetID())
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/recorder"
+)
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	c := &Config{Region: "us-south"}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession should not fail without credentials: %s", err)
+	}
+	sess := meta.(ClientSession)
+
+	if _, err := sess.BluemixSession(); err != errEmptyBluemixCredentials {
+		t.Fatalf("Expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+	if _, err := sess.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("Expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+	if sess.SoftLayerSession() == nil {
+		t.Fatal("SoftLayer session should always be configured")
+	}
+}
+
+func TestClientSessionConcurrentAccess(t *testing.T) {
+	c := &Config{Region: "us-south"}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession should not fail without credentials: %s", err)
+	}
+	sess := meta.(ClientSession)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := sess.IAMIdentityV1API(); err != errEmptyBluemixCredentials {
+				t.Errorf("Expected %q, got %v", errEmptyBluemixCredentials, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClientSessionBuildsClientsLazily(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+
+	c := &Config{BluemixAPIKey: "apikey", Region: "us-south"}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := meta.(*clientSession)
+	if calls != 0 || sess.vpcAPI != nil || sess.iamIdentityAPI != nil || sess.resourceManagerAPI != nil {
+		t.Fatalf("Expected no authentication nor client before the first use, got %d calls", calls)
+	}
+
+	vpcAPI, err := sess.VpcV1API()
+	if err != nil || vpcAPI == nil {
+		t.Fatalf("Expected the VPC client, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the first client to authenticate once, got %d calls", calls)
+	}
+	if sess.iamIdentityAPI != nil || sess.resourceManagerAPI != nil {
+		t.Error("Expected only the VPC client to be built")
+	}
+
+	again, err := sess.VpcV1API()
+	if err != nil || again != vpcAPI {
+		t.Errorf("Expected the VPC client to be reused, got %p and %p (%v)", vpcAPI, again, err)
+	}
+	if _, err := sess.IAMIdentityV1API(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the later clients to reuse the authentication, got %d calls", calls)
+	}
+}
+
+func TestAuthenticationRetriesTokenEndpoint(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity/token" {
+			t.Errorf("Expected a token request, got %s", r.URL.Path)
+		}
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name         string
+		config       bluemix.Config
+		authenticate func(*bxsession.Session) error
+	}{
+		{"API key", bluemix.Config{BluemixAPIKey: "apikey"}, authenticateAPIKey},
+		{"refresh token", bluemix.Config{IAMAccessToken: "Bearer expired", IAMRefreshToken: "refresh"}, RefreshToken},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			atomic.StoreInt32(&calls, 0)
+			config := c.config
+			config.TokenProviderEndpoint = &server.URL
+			config.HTTPClient = testRetryPolicy(3).HTTPClient(time.Minute)
+			sess, err := bxsession.New(&config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.authenticate(sess); err != nil {
+				t.Fatalf("Expected the 503 to be retried, got %s", err)
+			}
+			if calls != 2 {
+				t.Errorf("Expected 2 calls, got %d", calls)
+			}
+			if sess.Config.IAMAccessToken != "Bearer access" {
+				t.Errorf("Expected the new access token, got %q", sess.Config.IAMAccessToken)
+			}
+		})
+	}
+}
+
+func TestAuthenticationReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	defer func() { WrapTransport = nil }()
+
+	authenticate := func(rec *recorder.Recorder) error {
+		WrapTransport = rec.Wrap
+		if err := rec.Start("TestAccAuthentication"); err != nil {
+			t.Fatal(err)
+		}
+		defer rec.Stop()
+		config := bluemix.Config{
+			BluemixAPIKey:         "apikey",
+			TokenProviderEndpoint: &server.URL,
+			HTTPClient:            testRetryPolicy(1).HTTPClient(time.Minute),
+		}
+		sess, err := bxsession.New(&config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return authenticateAPIKey(sess)
+	}
+
+	dir := t.TempDir()
+	if err := authenticate(recorder.New(recorder.ModeRecord, dir)); err != nil {
+		t.Fatal(err)
+	}
+	// The token request is served from the cassette
+	server.Close()
+	if err := authenticate(recorder.New(recorder.ModeReplay, dir)); err != nil {
+		t.Errorf("Expected the authentication to be replayed, got %s", err)
+	}
+}
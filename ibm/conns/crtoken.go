@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultInstanceMetadataURL is the VPC instance metadata service, it is only
+// reachable from the VPC instances.
+const DefaultInstanceMetadataURL = "http://169.254.169.254"
+
+const (
+	crTokenGrantType        = "urn:ibm:params:oauth:grant-type:cr-token"
+	instanceMetadataVersion = "2022-03-01"
+	// Lifetime requested for the instance identity token, it is only used
+	// to get the IAM token
+	instanceIdentityTokenLifetime = 300
+)
+
+// ComputeResourceAuthenticator authenticates as a trusted profile with the
+// compute resource token of the workload, so that workloads running inside
+// IBM Cloud don't need an API key. The token is either read from a file, like
+// the projected service account token of a pod, or the IAM token is requested
+// from the VPC instance metadata service.
+//
+// The IAM access token is refreshed once 80% of its lifetime has elapsed, so
+// it never expires during long applies.
+type ComputeResourceAuthenticator struct {
+	// File of the compute resource token. It is read at each refresh, the
+	// token is rotated by the kubelet.
+	CRTokenFile string
+	// Base URL of the VPC instance metadata service, used when CRTokenFile
+	// is empty
+	InstanceMetadataURL string
+	// Trusted profile to assume. It is required with CRTokenFile, the
+	// instance metadata service defaults to the profile linked to the
+	// instance.
+	ProfileID string
+	// IAM endpoint
+	URL    string
+	Client *gohttp.Client
+
+	mu          sync.Mutex
+	accessToken string
+	refreshAt   time.Time
+	expiresAt   time.Time
+	// Tokens issued before the current one, see Transport
+	previous map[string]bool
+}
+
+// tokenResponse is the subset of the IAM and instance metadata token responses
+// the authenticator reads.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// AuthenticationType implements core.Authenticator.
+func (a *ComputeResourceAuthenticator) AuthenticationType() string {
+	return "crAuth"
+}
+
+// Validate implements core.Authenticator.
+func (a *ComputeResourceAuthenticator) Validate() error {
+	if a.CRTokenFile == "" && a.InstanceMetadataURL == "" {
+		return fmt.Errorf("[ERROR] A compute resource token file or the instance metadata service is required")
+	}
+	if a.CRTokenFile != "" && a.ProfileID == "" {
+		return fmt.Errorf("[ERROR] iam_profile_id is required to authenticate with a compute resource token")
+	}
+	return nil
+}
+
+// Authenticate implements core.Authenticator, it sets the bearer token of the
+// request.
+func (a *ComputeResourceAuthenticator) Authenticate(req *gohttp.Request) error {
+	token, err := a.Token()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns a valid IAM access token, requesting a new one when the
+// current one is due for a refresh. A failed refresh is only reported once the
+// current token has expired.
+func (a *ComputeResourceAuthenticator) Token() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	if a.accessToken != "" && now.Before(a.refreshAt) {
+		return a.accessToken, nil
+	}
+	response, err := a.requestToken()
+	if err != nil {
+		if a.accessToken != "" && now.Before(a.expiresAt) {
+			log.Printf("[WARN] Error refreshing the IAM token of the trusted profile, the current token is used until it expires: %s", err)
+			return a.accessToken, nil
+		}
+		return "", err
+	}
+	if response.AccessToken == "" {
+		return "", fmt.Errorf("[ERROR] The token response of the trusted profile has no access token")
+	}
+	lifetime := time.Duration(response.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = time.Hour
+	}
+	if a.accessToken != "" {
+		if a.previous == nil {
+			a.previous = map[string]bool{}
+		}
+		a.previous[a.accessToken] = true
+	}
+	a.accessToken = response.AccessToken
+	a.expiresAt = now.Add(lifetime)
+	a.refreshAt = now.Add(lifetime * 8 / 10)
+	return a.accessToken, nil
+}
+
+func (a *ComputeResourceAuthenticator) requestToken() (*tokenResponse, error) {
+	if a.CRTokenFile != "" {
+		return a.requestIAMToken()
+	}
+	return a.requestInstanceMetadataToken()
+}
+
+// requestIAMToken exchanges the compute resource token for an IAM token of the
+// trusted profile.
+func (a *ComputeResourceAuthenticator) requestIAMToken() (*tokenResponse, error) {
+	crToken, err := ioutil.ReadFile(a.CRTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the compute resource token: %s", err)
+	}
+	form := url.Values{
+		"grant_type": {crTokenGrantType},
+		"cr_token":   {strings.TrimSpace(string(crToken))},
+		"profile_id": {a.ProfileID},
+	}
+	req, err := gohttp.NewRequest(gohttp.MethodPost, strings.TrimSuffix(a.URL, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	return a.do(req, "requesting the IAM token of the trusted profile")
+}
+
+// requestInstanceMetadataToken gets an instance identity token from the
+// instance metadata service and exchanges it for an IAM token.
+func (a *ComputeResourceAuthenticator) requestInstanceMetadataToken() (*tokenResponse, error) {
+	base := strings.TrimSuffix(a.InstanceMetadataURL, "/")
+	body, _ := json.Marshal(map[string]interface{}{"expires_in": instanceIdentityTokenLifetime})
+	req, err := gohttp.NewRequest(gohttp.MethodPut, fmt.Sprintf("%s/instance_identity/v1/token?version=%s", base, instanceMetadataVersion), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "ibm")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	identity, err := a.do(req, "requesting the instance identity token")
+	if err != nil {
+		return nil, err
+	}
+
+	body = []byte("{}")
+	if a.ProfileID != "" {
+		body, _ = json.Marshal(map[string]interface{}{
+			"trusted_profile": map[string]interface{}{"id": a.ProfileID},
+		})
+	}
+	req, err = gohttp.NewRequest(gohttp.MethodPost, fmt.Sprintf("%s/instance_identity/v1/iam_token?version=%s", base, instanceMetadataVersion), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+identity.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	return a.do(req, "requesting the IAM token from the instance metadata service")
+}
+
+func (a *ComputeResourceAuthenticator) do(req *gohttp.Request, action string) (*tokenResponse, error) {
+	client := a.Client
+	if client == nil {
+		client = gohttp.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error %s: %s", action, err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error %s: %s", action, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("[ERROR] Error %s, status %d: %s", action, resp.StatusCode, data)
+	}
+	response := &tokenResponse{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error %s, invalid response: %s", action, err)
+	}
+	return response, nil
+}
+
+// Transport wraps base so that the requests carrying a token issued by the
+// authenticator are sent with a valid token, refreshed if needed. The
+// bluemix-go clients read the token from their session and can't refresh a
+// token without a refresh token.
+func (a *ComputeResourceAuthenticator) Transport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	return &crTokenTransport{authenticator: a, base: base}
+}
+
+type crTokenTransport struct {
+	authenticator *ComputeResourceAuthenticator
+	base          gohttp.RoundTripper
+}
+
+func (t *crTokenTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return t.base.RoundTrip(req)
+	}
+	sent := strings.TrimPrefix(header, "Bearer ")
+	t.authenticator.mu.Lock()
+	issued := t.authenticator.previous[sent] || t.authenticator.accessToken == sent
+	t.authenticator.mu.Unlock()
+	if !issued {
+		return t.base.RoundTrip(req)
+	}
+	token, err := t.authenticator.Token()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testIAMServer is a stand-in for the IAM token endpoint issuing the tokens
+// token-1, token-2... valid for expiresIn seconds.
+func testIAMServer(t *testing.T, expiresIn int, fail *int32) (*httptest.Server, *[]string) {
+	var issued int32
+	crTokens := &[]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity/token" {
+			t.Errorf("Unexpected IAM request %s", r.URL.Path)
+		}
+		r.ParseForm()
+		if r.Form.Get("grant_type") != crTokenGrantType || r.Form.Get("profile_id") != "Profile-1" {
+			t.Errorf("Unexpected IAM token request %v", r.Form)
+		}
+		*crTokens = append(*crTokens, r.Form.Get("cr_token"))
+		if fail != nil && atomic.LoadInt32(fail) != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorCode":"BXNIM0415E"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("token-%d", atomic.AddInt32(&issued, 1)),
+			"expires_in":   expiresIn,
+		})
+	}))
+	return server, crTokens
+}
+
+func writeCRToken(t *testing.T, file, token string) {
+	if err := ioutil.WriteFile(file, []byte(token+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestComputeResourceAuthenticatorCRTokenFile(t *testing.T) {
+	server, crTokens := testIAMServer(t, 1, nil)
+	defer server.Close()
+	file := filepath.Join(t.TempDir(), "token")
+	writeCRToken(t, file, "cr-1")
+
+	a := &ComputeResourceAuthenticator{CRTokenFile: file, ProfileID: "Profile-1", URL: server.URL}
+	if err := a.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("GET", "https://example.com", nil)
+	if err := a.Authenticate(req); err != nil {
+		t.Fatal(err)
+	}
+	if v := req.Header.Get("Authorization"); v != "Bearer token-1" {
+		t.Errorf("Unexpected authorization %q", v)
+	}
+	if token, _ := a.Token(); token != "token-1" {
+		t.Errorf("Expected the token to be reused, got %q", token)
+	}
+
+	// The token is refreshed after 80% of its lifetime, with the rotated
+	// compute resource token
+	writeCRToken(t, file, "cr-2")
+	time.Sleep(900 * time.Millisecond)
+	if token, _ := a.Token(); token != "token-2" {
+		t.Errorf("Expected the token to be refreshed, got %q", token)
+	}
+	if len(*crTokens) != 2 || (*crTokens)[0] != "cr-1" || (*crTokens)[1] != "cr-2" {
+		t.Errorf("Unexpected compute resource tokens %v", *crTokens)
+	}
+}
+
+func TestComputeResourceAuthenticatorRefreshFailure(t *testing.T) {
+	var fail int32
+	server, _ := testIAMServer(t, 2, &fail)
+	defer server.Close()
+	file := filepath.Join(t.TempDir(), "token")
+	writeCRToken(t, file, "cr-1")
+
+	a := &ComputeResourceAuthenticator{CRTokenFile: file, ProfileID: "Profile-1", URL: server.URL}
+	if _, err := a.Token(); err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&fail, 1)
+	time.Sleep(1700 * time.Millisecond)
+	if token, err := a.Token(); err != nil || token != "token-1" {
+		t.Errorf("Expected the current token until it expires, got %q %v", token, err)
+	}
+	time.Sleep(500 * time.Millisecond)
+	if _, err := a.Token(); err == nil {
+		t.Errorf("Expected an error once the token has expired")
+	}
+}
+
+func TestComputeResourceAuthenticatorValidate(t *testing.T) {
+	if err := (&ComputeResourceAuthenticator{}).Validate(); err == nil {
+		t.Errorf("Expected an error without a token source")
+	}
+	if err := (&ComputeResourceAuthenticator{CRTokenFile: "token"}).Validate(); err == nil {
+		t.Errorf("Expected an error without a trusted profile")
+	}
+	if err := (&ComputeResourceAuthenticator{InstanceMetadataURL: DefaultInstanceMetadataURL}).Validate(); err != nil {
+		t.Errorf("Expected the instance metadata service not to need a trusted profile, got %s", err)
+	}
+	if _, err := (&ComputeResourceAuthenticator{CRTokenFile: filepath.Join(t.TempDir(), "missing"), ProfileID: "Profile-1"}).Token(); err == nil {
+		t.Errorf("Expected an error reading a missing token file")
+	}
+}
+
+func TestComputeResourceAuthenticatorInstanceMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("version") == "" {
+			t.Errorf("Expected a version, got %s", r.URL)
+		}
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/instance_identity/v1/token":
+			if r.Header.Get("Metadata-Flavor") != "ibm" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "identity", "expires_in": body["expires_in"]})
+		case r.Method == "POST" && r.URL.Path == "/instance_identity/v1/iam_token":
+			profile, _ := body["trusted_profile"].(map[string]interface{})
+			if r.Header.Get("Authorization") != "Bearer identity" || profile["id"] != "Profile-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "iam", "expires_in": 3600})
+		default:
+			t.Errorf("Unexpected metadata request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	a := &ComputeResourceAuthenticator{InstanceMetadataURL: server.URL + "/", ProfileID: "Profile-1"}
+	if token, err := a.Token(); err != nil || token != "iam" {
+		t.Errorf("Unexpected token %q %v", token, err)
+	}
+}
+
+func TestComputeResourceAuthenticatorTransport(t *testing.T) {
+	iam, _ := testIAMServer(t, 1, nil)
+	defer iam.Close()
+	file := filepath.Join(t.TempDir(), "token")
+	writeCRToken(t, file, "cr-1")
+	var received []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("Authorization"))
+	}))
+	defer api.Close()
+
+	a := &ComputeResourceAuthenticator{CRTokenFile: file, ProfileID: "Profile-1", URL: iam.URL}
+	first, _ := a.Token()
+	client := &http.Client{Transport: a.Transport(nil)}
+	send := func(authorization string) {
+		req, _ := http.NewRequest("GET", api.URL, nil)
+		req.Header.Set("Authorization", authorization)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	send("Bearer " + first)
+	time.Sleep(900 * time.Millisecond)
+	// A client still holding the first token gets the refreshed one, other
+	// tokens are left alone
+	send("Bearer " + first)
+	send("Bearer other")
+	expected := []string{"Bearer token-1", "Bearer token-2", "Bearer other"}
+	if fmt.Sprint(received) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, received)
+	}
+}
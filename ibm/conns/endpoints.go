@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ServiceEndpointKeys maps the arguments of the provider `endpoints` block to
+// the keys used in the endpoints file and as environment variables.
+var ServiceEndpointKeys = map[string]string{
+	"api_gateway":                "IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"appid":                      "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"atracker":                   "IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"catalog_management":         "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"cis":                        "IBMCLOUD_CIS_API_ENDPOINT",
+	"cloud_shell":                "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"compliance":                 "IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+	"container_registry":         "IBMCLOUD_CR_API_ENDPOINT",
+	"context_based_restrictions": "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"cos_config":                 "IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"directlink":                 "IBMCLOUD_DL_API_ENDPOINT",
+	"directlink_provider":        "IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"enterprise":                 "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"event_notifications":        "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"global_tagging":             "IBMCLOUD_GT_API_ENDPOINT",
+	"iam":                        "IBMCLOUD_IAM_API_ENDPOINT",
+	"instance_metadata":          "IBMCLOUD_INSTANCE_METADATA_API_ENDPOINT",
+	"kms":                        "IBMCLOUD_KP_API_ENDPOINT",
+	"private_dns":                "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"push_notifications":         "IBMCLOUD_PUSH_API_ENDPOINT",
+	"resource_controller":        "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"resource_manager":           "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"satellite":                  "IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"satellite_link":             "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"scc_findings":               "IBMCLOUD_SCC_FINDINGS_API_ENDPOINT",
+	"schematics":                 "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"transit_gateway":            "IBMCLOUD_TG_API_ENDPOINT",
+	"vpc":                        "IBMCLOUD_IS_NG_API_ENDPOINT",
+}
+
+// Keys that can only be customized through the endpoints file, they are read
+// by the bluemix-go clients or by individual services.
+var endpointsFileOnlyKeys = []string{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APP_CONFIG_API_ENDPOINT",
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT",
+	"IBMCLOUD_CS_API_ENDPOINT",
+	"IBMCLOUD_DATABASES_API_ENDPOINT",
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"IBMCLOUD_GS_API_ENDPOINT",
+	"IBMCLOUD_HPCS_API_ENDPOINT",
+	"IBMCLOUD_HPCS_TKE_ENDPOINT",
+	"IBMCLOUD_ICD_API_ENDPOINT",
+	"IBMCLOUD_MCCP_API_ENDPOINT",
+	"IBMCLOUD_PI_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_SCC_ADMIN_API_ENDPOINT",
+	"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT",
+	"IBMCLOUD_UAA_ENDPOINT",
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+}
+
+var endpointsFileVisibilities = []string{"public", "private", "public-and-private"}
+
+var endpointsFileRegions = []string{
+	"au-syd", "br-sao", "ca-tor", "eu-de", "eu-fr", "eu-gb", "jp-osa", "jp-tok", "us-east", "us-south",
+}
+
+func isEndpointsFileKey(key string) bool {
+	for _, k := range ServiceEndpointKeys {
+		if k == key {
+			return true
+		}
+	}
+	return stringInSlice(key, endpointsFileOnlyKeys)
+}
+
+func stringInSlice(str string, list []string) bool {
+	for _, v := range list {
+		if v == str {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadEndpointsFile reads the endpoints file at path and validates its content.
+// Problems that make the file unusable are reported as errors, unknown
+// services, visibilities and regions only produce warnings.
+func ReadEndpointsFile(path string) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	jsonFile, err := os.Open(path)
+	if err != nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to open endpoints file",
+			Detail:   err.Error(),
+		})
+	}
+	defer jsonFile.Close()
+	bytes, err := ioutil.ReadAll(jsonFile)
+	if err != nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read endpoints file",
+			Detail:   fmt.Sprintf("%s: %s", path, err),
+		})
+	}
+	var fileMap map[string]interface{}
+	if err := json.Unmarshal(bytes, &fileMap); err != nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to parse endpoints file",
+			Detail:   fmt.Sprintf("%s: %s", path, err),
+		})
+	}
+
+	keys := make([]string, 0, len(fileMap))
+	for key := range fileMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !isEndpointsFileKey(key) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unknown service in endpoints file",
+				Detail:   fmt.Sprintf("%s: %q is not a supported endpoint variable and will be ignored", path, key),
+			})
+		}
+		visibilities, ok := fileMap[key].(map[string]interface{})
+		if !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid endpoints file",
+				Detail:   fmt.Sprintf("%s: %q must be an object keyed by visibility (%s)", path, key, strings.Join(endpointsFileVisibilities, ", ")),
+			})
+			continue
+		}
+		for visibility, v := range visibilities {
+			if !stringInSlice(visibility, endpointsFileVisibilities) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Unknown visibility in endpoints file",
+					Detail:   fmt.Sprintf("%s: %q of %q is not one of %s and will be ignored", path, visibility, key, strings.Join(endpointsFileVisibilities, ", ")),
+				})
+			}
+			regions, ok := v.(map[string]interface{})
+			if !ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid endpoints file",
+					Detail:   fmt.Sprintf("%s: %q of %q must be an object keyed by region", path, visibility, key),
+				})
+				continue
+			}
+			for region, endpoint := range regions {
+				if !stringInSlice(region, endpointsFileRegions) {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Unknown region in endpoints file",
+						Detail:   fmt.Sprintf("%s: %q of %q.%q is not a known IBM Cloud region", path, region, key, visibility),
+					})
+				}
+				if s, ok := endpoint.(string); !ok || s == "" {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Invalid endpoints file",
+						Detail:   fmt.Sprintf("%s: the endpoint of %q.%q.%q must be a non empty string", path, key, visibility, region),
+					})
+				}
+			}
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return fileMap, diags
+}
+
+// diagnosticsError flattens the error diagnostics into a single error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var msgs []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] %s", strings.Join(msgs, "\n"))
+}
+
+// serviceEndpoint resolves the endpoint of the service identified by key from
+// the provider endpoints block first and then from the endpoints file.
+// defaultURL is returned when neither of them has an entry for the service.
+func (session *clientSession) serviceEndpoint(key, defaultURL string) string {
+	c := session.config
+	if url, ok := c.Endpoints[key]; ok && url != "" {
+		return url
+	}
+	if session.fileMap != nil {
+		return fileFallBack(session.fileMap, c.Visibility, key, c.Region, defaultURL)
+	}
+	return defaultURL
+}
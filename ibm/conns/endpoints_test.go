@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func writeEndpointsFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "endpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "endpoints.json")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func countSeverity(diags diag.Diagnostics, severity diag.Severity) int {
+	n := 0
+	for _, d := range diags {
+		if d.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+func TestReadEndpointsFile(t *testing.T) {
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"public": {"us-south": "https://vpc.example.com/v1"},
+			"private": {"us-south": "https://private.vpc.example.com/v1"}
+		}
+	}`)
+	fileMap, diags := ReadEndpointsFile(path)
+	if len(diags) != 0 {
+		t.Fatalf("Expected no diagnostics, got %v", diags)
+	}
+	if fileMap == nil {
+		t.Fatal("Expected the endpoints file to be returned")
+	}
+}
+
+func TestReadEndpointsFileWarnings(t *testing.T) {
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_UNKNOWN_API_ENDPOINT": {"public": {"us-south": "https://unknown.example.com"}},
+		"IBMCLOUD_IAM_API_ENDPOINT": {"public": {"mars-north": "https://iam.example.com"}}
+	}`)
+	fileMap, diags := ReadEndpointsFile(path)
+	if diags.HasError() {
+		t.Fatalf("Expected only warnings, got %v", diags)
+	}
+	if n := countSeverity(diags, diag.Warning); n != 2 {
+		t.Fatalf("Expected 2 warnings, got %d: %v", n, diags)
+	}
+	if fileMap == nil {
+		t.Fatal("Expected the endpoints file to be returned")
+	}
+}
+
+func TestReadEndpointsFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"malformed":      `{"IBMCLOUD_IAM_API_ENDPOINT": `,
+		"not an object":  `{"IBMCLOUD_IAM_API_ENDPOINT": "https://iam.example.com"}`,
+		"no regions":     `{"IBMCLOUD_IAM_API_ENDPOINT": {"public": "https://iam.example.com"}}`,
+		"empty endpoint": `{"IBMCLOUD_IAM_API_ENDPOINT": {"public": {"us-south": ""}}}`,
+	}
+	for name, content := range cases {
+		path := writeEndpointsFile(t, content)
+		fileMap, diags := ReadEndpointsFile(path)
+		if !diags.HasError() {
+			t.Errorf("%s: expected an error, got %v", name, diags)
+		}
+		if fileMap != nil {
+			t.Errorf("%s: expected no endpoints to be returned", name)
+		}
+	}
+
+	if _, diags := ReadEndpointsFile(filepath.Join(os.TempDir(), "does-not-exist.json")); !diags.HasError() {
+		t.Error("Expected an error for a missing file")
+	}
+}
+
+func TestFileFallBack(t *testing.T) {
+	fileMap := map[string]interface{}{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": map[string]interface{}{
+			"public":  map[string]interface{}{"us-south": "https://public"},
+			"private": map[string]interface{}{"us-east": "https://private"},
+		},
+	}
+	cases := []struct {
+		visibility, region, expected string
+	}{
+		{"public", "us-south", "https://public"},
+		{"private", "us-south", "default"},
+		{"private", "us-east", "https://private"},
+		{"public-and-private", "us-east", "https://private"},
+		{"public-and-private", "us-south", "https://public"},
+		{"public-and-private", "eu-de", "default"},
+	}
+	for _, tc := range cases {
+		if v := fileFallBack(fileMap, tc.visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", tc.region, "default"); v != tc.expected {
+			t.Errorf("%s/%s: expected %q, got %q", tc.visibility, tc.region, tc.expected, v)
+		}
+	}
+	if v := fileFallBack(fileMap, "public", "IBMCLOUD_IAM_API_ENDPOINT", "us-south", "default"); v != "default" {
+		t.Errorf("Expected the default for a missing key, got %q", v)
+	}
+}
+
+func TestServiceEndpoint(t *testing.T) {
+	session := &clientSession{
+		config: &Config{
+			Region:     "us-south",
+			Visibility: "public",
+			Endpoints:  map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "https://block"},
+		},
+		fileMap: map[string]interface{}{
+			"IBMCLOUD_IS_NG_API_ENDPOINT": map[string]interface{}{
+				"public": map[string]interface{}{"us-south": "https://file"},
+			},
+			"IBMCLOUD_IAM_API_ENDPOINT": map[string]interface{}{
+				"public": map[string]interface{}{"us-south": "https://iam-file"},
+			},
+		},
+	}
+	if v := session.serviceEndpoint("IBMCLOUD_IS_NG_API_ENDPOINT", "default"); v != "https://block" {
+		t.Errorf("Expected the endpoints block to take precedence, got %q", v)
+	}
+	if v := session.serviceEndpoint("IBMCLOUD_IAM_API_ENDPOINT", "default"); v != "https://iam-file" {
+		t.Errorf("Expected the endpoints file value, got %q", v)
+	}
+	if v := session.serviceEndpoint("IBMCLOUD_CIS_API_ENDPOINT", "default"); v != "default" {
+		t.Errorf("Expected the default value, got %q", v)
+	}
+}
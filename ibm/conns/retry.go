@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	gohttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Defaults of the provider retry block
+const (
+	DefaultRetryMinDelay = 1 * time.Second
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried when the
+// retry block doesn't list any.
+var DefaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+// RetryPolicy is shared by the bluemix-go, platform services and SoftLayer
+// clients. Failed requests are retried with an exponential backoff with full
+// jitter, and the Retry-After header of 429 and 503 responses is honored.
+type RetryPolicy struct {
+	// Total number of attempts, including the first one
+	MaxAttempts int
+	MinDelay    time.Duration
+	MaxDelay    time.Duration
+	// Response status codes that are retried
+	RetryableStatusCodes []int
+}
+
+// withDefaults fills the unset fields of the policy. maxRetries is the
+// provider max_retries argument, used when MaxAttempts isn't set.
+func (p RetryPolicy) withDefaults(maxRetries int) RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = maxRetries + 1
+	}
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 1
+	}
+	if p.MinDelay <= 0 {
+		p.MinDelay = DefaultRetryMinDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultRetryMaxDelay
+	}
+	if p.MaxDelay < p.MinDelay {
+		p.MaxDelay = p.MinDelay
+	}
+	if len(p.RetryableStatusCodes) == 0 {
+		p.RetryableStatusCodes = DefaultRetryableStatusCodes
+	}
+	return p
+}
+
+// ShouldRetry reports whether a request that ended with resp or err is worth
+// another attempt.
+func (p RetryPolicy) ShouldRetry(resp *gohttp.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		var netErr net.Error
+		return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	if resp == nil {
+		return false
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns how long to wait before retrying after the given attempt,
+// starting at 0. The Retry-After header of a 429 or 503 response takes
+// precedence over the exponential backoff, capped to MaxDelay.
+func (p RetryPolicy) Backoff(attempt int, resp *gohttp.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == gohttp.StatusTooManyRequests || resp.StatusCode == gohttp.StatusServiceUnavailable) {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if d > p.MaxDelay {
+				return p.MaxDelay
+			}
+			return d
+		}
+	}
+	ceiling := p.MaxDelay
+	if attempt < 32 {
+		if d := p.MinDelay << uint(attempt); d > 0 && d < ceiling {
+			ceiling = d
+		}
+	}
+	if ceiling <= p.MinDelay {
+		return p.MinDelay
+	}
+	return p.MinDelay + time.Duration(rand.Int63n(int64(ceiling-p.MinDelay)+1))
+}
+
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := gohttp.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// WrapTransport, when set, wraps the base transport of every client built by
+// the provider, below the retry policy. The acceptance tests set it to record
+// and replay the API calls.
+var WrapTransport func(gohttp.RoundTripper) gohttp.RoundTripper
+
+// Transport wraps base so that requests are retried according to the policy.
+// A nil base uses http.DefaultTransport.
+func (p RetryPolicy) Transport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	if WrapTransport != nil {
+		base = WrapTransport(base)
+	}
+	return &retryTransport{policy: p, base: base}
+}
+
+// HTTPClient returns an http.Client with the given timeout that retries
+// according to the policy.
+func (p RetryPolicy) HTTPClient(timeout time.Duration) *gohttp.Client {
+	return &gohttp.Client{
+		Transport: p.Transport(nil),
+		Timeout:   timeout,
+	}
+}
+
+type retryTransport struct {
+	policy RetryPolicy
+	base   gohttp.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	req = req.Clone(req.Context())
+	if req.Body != nil && req.Body != gohttp.NoBody && req.GetBody == nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if attempt+1 >= t.policy.MaxAttempts || !t.policy.ShouldRetry(resp, err) {
+			return resp, err
+		}
+		delay := t.policy.Backoff(attempt, resp)
+		if resp != nil {
+			log.Printf("[DEBUG] %s %s returned %d, retrying in %s (attempt %d of %d)", req.Method, req.URL.Redacted(), resp.StatusCode, delay, attempt+2, t.policy.MaxAttempts)
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] %s %s failed: %s, retrying in %s (attempt %d of %d)", req.Method, req.URL.Redacted(), err, delay, attempt+2, t.policy.MaxAttempts)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// enableRetries routes the requests of a platform services client through the
+// provider retry policy.
+func (session *clientSession) enableRetries(service *core.BaseService) {
+	service.SetHTTPClient(session.retryHTTPClient(service.Client))
+}
+
+// retryHTTPClient returns a copy of client, or of an empty client when nil,
+// whose requests are retried according to the provider retry policy. It
+// covers the clients built on an older go-sdk-core.
+func (session *clientSession) retryHTTPClient(client *gohttp.Client) *gohttp.Client {
+	retryClient := &gohttp.Client{}
+	if client != nil {
+		c := *client
+		retryClient = &c
+	}
+	retryClient.Transport = session.config.retryPolicy().Transport(retryClient.Transport)
+	return retryClient
+}
+
+// retryPolicy returns the retry policy of the provider with defaults applied.
+func (c *Config) retryPolicy() RetryPolicy {
+	return c.RetryPolicy.withDefaults(c.RetryCount)
+}
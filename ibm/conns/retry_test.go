@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: maxAttempts,
+		MinDelay:    time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+	}.withDefaults(0)
+}
+
+func TestRetryTransportRetriesThrottledRequests(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("Expected the request body to be replayed, got %q", body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := testRetryPolicy(5).HTTPClient(time.Minute)
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransportStopsAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	resp, err := testRetryPolicy(3).HTTPClient(time.Minute).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected 503, got %d", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransportDoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	resp, err := testRetryPolicy(3).HTTPClient(time.Minute).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MinDelay: time.Second, MaxDelay: 10 * time.Second}.withDefaults(0)
+	for attempt := 0; attempt < 40; attempt++ {
+		d := p.Backoff(attempt, nil)
+		if d < p.MinDelay || d > p.MaxDelay {
+			t.Fatalf("attempt %d: backoff %s out of [%s, %s]", attempt, d, p.MinDelay, p.MaxDelay)
+		}
+	}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	if d := p.Backoff(0, resp); d != 3*time.Second {
+		t.Fatalf("Expected Retry-After to be honored, got %s", d)
+	}
+	resp.Header.Set("Retry-After", "120")
+	if d := p.Backoff(0, resp); d != p.MaxDelay {
+		t.Fatalf("Expected Retry-After to be capped to %s, got %s", p.MaxDelay, d)
+	}
+}
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	p := RetryPolicy{}.withDefaults(10)
+	if p.MaxAttempts != 11 {
+		t.Errorf("Expected max_retries + 1 attempts, got %d", p.MaxAttempts)
+	}
+	if p.MinDelay != DefaultRetryMinDelay || p.MaxDelay != DefaultRetryMaxDelay {
+		t.Errorf("Unexpected default delays %s, %s", p.MinDelay, p.MaxDelay)
+	}
+	if len(p.RetryableStatusCodes) != len(DefaultRetryableStatusCodes) {
+		t.Errorf("Expected the default retryable status codes, got %v", p.RetryableStatusCodes)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Response headers that carry the IBM Cloud transaction ID, in order of
+// precedence
+var transactionIDHeaders = []string{"X-Correlation-Id", "Transaction-Id", "X-Request-Id", "X-Global-Transaction-Id"}
+
+// APIErrorDetail is an entry of the errors array of an IBM Cloud API error
+// response.
+type APIErrorDetail struct {
+	Code     string
+	Message  string
+	MoreInfo string
+}
+
+// APIError is an error returned by an IBM Cloud API, with the details support
+// needs to trace the request. Use NewAPIError to build it from the error and
+// response returned by a go-sdk-core based client.
+type APIError struct {
+	Service       string
+	Operation     string
+	StatusCode    int
+	TransactionID string
+	Message       string
+	Errors        []APIErrorDetail
+
+	err error
+}
+
+// NewAPIError wraps err, returned by the operation of the given service, with
+// the status code, transaction ID and error codes of response. response can be
+// nil when the request didn't reach the API.
+func NewAPIError(service, operation string, err error, response *core.DetailedResponse) *APIError {
+	apiErr := &APIError{
+		Service:   service,
+		Operation: operation,
+		err:       err,
+	}
+	if err != nil {
+		apiErr.Message = err.Error()
+	}
+	if response == nil {
+		return apiErr
+	}
+	apiErr.StatusCode = response.StatusCode
+	for _, header := range transactionIDHeaders {
+		if v := response.Headers.Get(header); v != "" {
+			apiErr.TransactionID = v
+			break
+		}
+	}
+
+	body, ok := response.Result.(map[string]interface{})
+	if !ok && len(response.RawResult) > 0 {
+		json.Unmarshal(response.RawResult, &body)
+	}
+	if body == nil {
+		return apiErr
+	}
+	if apiErr.TransactionID == "" {
+		for _, key := range []string{"trace", "transaction_id", "incidentID"} {
+			if v, ok := body[key].(string); ok && v != "" {
+				apiErr.TransactionID = v
+				break
+			}
+		}
+	}
+	if list, ok := body["errors"].([]interface{}); ok {
+		for _, e := range list {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			detail := APIErrorDetail{}
+			detail.Code, _ = m["code"].(string)
+			detail.Message, _ = m["message"].(string)
+			detail.MoreInfo, _ = m["more_info"].(string)
+			apiErr.Errors = append(apiErr.Errors, detail)
+		}
+	}
+	if apiErr.Message == "" {
+		if len(apiErr.Errors) > 0 {
+			apiErr.Message = apiErr.Errors[0].Message
+		} else if v, ok := body["message"].(string); ok {
+			apiErr.Message = v
+		}
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[ERROR] %s %s failed", e.Service, e.Operation)
+	if e.StatusCode != 0 {
+		fmt.Fprintf(&b, " with status %d", e.StatusCode)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	if codes := e.Codes(); len(codes) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(codes, ", "))
+	}
+	if e.TransactionID != "" {
+		fmt.Fprintf(&b, ", transaction ID %s", e.TransactionID)
+	}
+	return b.String()
+}
+
+// Unwrap returns the error returned by the client.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// Codes returns the error codes of the response.
+func (e *APIError) Codes() []string {
+	var codes []string
+	for _, detail := range e.Errors {
+		if detail.Code != "" {
+			codes = append(codes, detail.Code)
+		}
+	}
+	return codes
+}
+
+// HasCode reports whether the response contains the given error code.
+func (e *APIError) HasCode(code string) bool {
+	for _, detail := range e.Errors {
+		if detail.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Diagnostic renders the error as an error diagnostic. The summary holds the
+// failing operation and the message, the detail everything support needs.
+func (e *APIError) Diagnostic() diag.Diagnostic {
+	summary := fmt.Sprintf("%s %s failed", e.Service, e.Operation)
+	if e.Message != "" {
+		summary = fmt.Sprintf("%s: %s", summary, e.Message)
+	}
+	var detail []string
+	if e.StatusCode != 0 {
+		detail = append(detail, fmt.Sprintf("Status code: %d", e.StatusCode))
+	}
+	if e.TransactionID != "" {
+		detail = append(detail, fmt.Sprintf("Transaction ID: %s", e.TransactionID))
+	}
+	for _, d := range e.Errors {
+		line := fmt.Sprintf("Error code: %s", d.Code)
+		if d.Message != "" && d.Message != e.Message {
+			line = fmt.Sprintf("%s, %s", line, d.Message)
+		}
+		detail = append(detail, line)
+		if d.MoreInfo != "" {
+			detail = append(detail, fmt.Sprintf("More info: %s", d.MoreInfo))
+		}
+	}
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   strings.Join(detail, "\n"),
+	}
+}
+
+// APIErrorDiagnostics is a shorthand for resources returning diag.Diagnostics:
+// it builds the APIError of the failed operation and renders it.
+func APIErrorDiagnostics(service, operation string, err error, response *core.DetailedResponse) diag.Diagnostics {
+	return diag.Diagnostics{NewAPIError(service, operation, err, response).Diagnostic()}
+}
+
+// HasAPIErrorCode reports whether err wraps an APIError with the given error
+// code.
+func HasAPIErrorCode(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.HasCode(code)
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestNewAPIError(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: 400,
+		Headers:    http.Header{"X-Correlation-Id": []string{"corr-123"}},
+		Result: map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{
+					"code":      "validation_required_field_missing",
+					"message":   "Name is required",
+					"more_info": "https://cloud.ibm.com/docs/vpc",
+				},
+			},
+			"trace": "trace-456",
+		},
+	}
+	apiErr := NewAPIError("vpc", "CreateVPC", errors.New("Name is required"), response)
+
+	if apiErr.StatusCode != 400 {
+		t.Errorf("Expected status code 400, got %d", apiErr.StatusCode)
+	}
+	if apiErr.TransactionID != "corr-123" {
+		t.Errorf("Expected the correlation header to take precedence, got %q", apiErr.TransactionID)
+	}
+	if !apiErr.HasCode("validation_required_field_missing") {
+		t.Errorf("Expected the error code to be parsed, got %v", apiErr.Errors)
+	}
+	if !strings.Contains(apiErr.Error(), "corr-123") {
+		t.Errorf("Expected the transaction ID in %q", apiErr.Error())
+	}
+
+	d := apiErr.Diagnostic()
+	if d.Severity != diag.Error {
+		t.Errorf("Expected an error diagnostic")
+	}
+	for _, s := range []string{"Status code: 400", "Transaction ID: corr-123", "Error code: validation_required_field_missing", "More info: https://cloud.ibm.com/docs/vpc"} {
+		if !strings.Contains(d.Detail, s) {
+			t.Errorf("Expected %q in the detail %q", s, d.Detail)
+		}
+	}
+
+	wrapped := fmt.Errorf("creating VPC: %w", apiErr)
+	if !HasAPIErrorCode(wrapped, "validation_required_field_missing") {
+		t.Errorf("Expected HasAPIErrorCode to unwrap the error")
+	}
+}
+
+func TestNewAPIErrorWithoutResponse(t *testing.T) {
+	apiErr := NewAPIError("vpc", "GetVPC", errors.New("connection refused"), nil)
+	if apiErr.StatusCode != 0 || apiErr.TransactionID != "" {
+		t.Errorf("Unexpected details %+v", apiErr)
+	}
+	if apiErr.Error() != "[ERROR] vpc GetVPC failed: connection refused" {
+		t.Errorf("Unexpected message %q", apiErr.Error())
+	}
+}
+
+func TestNewAPIErrorTraceFromBody(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: 500,
+		RawResult:  []byte(`{"trace": "trace-789", "errors": [{"code": "internal_error", "message": "Internal error"}]}`),
+	}
+	apiErr := NewAPIError("vpc", "ListVpcs", errors.New("Internal error"), response)
+	if apiErr.TransactionID != "trace-789" {
+		t.Errorf("Expected the trace of the body, got %q", apiErr.TransactionID)
+	}
+	if !apiErr.HasCode("internal_error") {
+		t.Errorf("Expected the error code to be parsed, got %v", apiErr.Errors)
+	}
+}
+
+func TestBeautifyError(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: 404,
+		Headers:    http.Header{"X-Request-Id": []string{"req-789"}},
+		Result: map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{"code": "not_found", "message": "VPC not found"},
+			},
+		},
+	}
+	out := BeautifyError(errors.New("VPC not found"), response)
+
+	if out.StatusCode != 404 || out.TransactionID != "req-789" {
+		t.Errorf("Expected the status code and transaction ID of the response, got %+v", out)
+	}
+	if len(out.Errors) != 1 || out.Errors[0].Code != "not_found" {
+		t.Errorf("Expected the error codes of the response, got %v", out.Errors)
+	}
+	if !strings.Contains(out.String(), "req-789") {
+		t.Errorf("Expected the transaction ID in %q", out.String())
+	}
+}
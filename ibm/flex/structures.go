@@ -1972,6 +1972,7 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, *item.Name)
 	}
+	taglist = RemoveIgnoredTags(meta, taglist)
 	log.Println("tagList: ", taglist)
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
@@ -2000,6 +2001,9 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	}
 	olds := oldList.(*schema.Set)
 	news := newList.(*schema.Set)
+	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+		news = MergeDefaultTags(meta, news)
+	}
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
@@ -2019,6 +2023,8 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			add = append(add, envTags...)
 		}
 	}
+	add = RemoveIgnoredTags(meta, add)
+	remove = RemoveIgnoredTags(meta, remove)
 
 	if len(remove) > 0 {
 		detachTagOptions := &globaltaggingv1.DetachTagOptions{}
@@ -2094,6 +2100,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, item.Name)
 	}
+	taglist = RemoveIgnoredTags(meta, taglist)
 	log.Println("tagList: ", taglist)
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
@@ -2110,7 +2117,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		newList = new(schema.Set)
 	}
 	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	news := MergeDefaultTags(meta, newList.(*schema.Set))
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
@@ -2128,6 +2135,8 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		envTags = strings.Split(schematicTags, ",")
 		add = append(add, envTags...)
 	}
+	add = RemoveIgnoredTags(meta, add)
+	remove = RemoveIgnoredTags(meta, remove)
 
 	if len(remove) > 0 {
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
@@ -2171,7 +2180,10 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+// ResourceTagsCustomizeDiff suppresses the tags diff caused by the tags the
+// provider attaches on its own (IC_ENV_TAGS and default_tags), and plans the
+// tags_all attribute of the resources that declare it.
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
@@ -2183,11 +2195,111 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 			s := strings.Split(v, ",")
 			if len(removeInt) == len(s) && len(addInt) == 0 {
 				fmt.Println("Suppresing the TAG diff ")
-				return diff.Clear("tags")
+				if err := diff.Clear("tags"); err != nil {
+					return err
+				}
+				return resourceTagsAllCustomizeDiff(diff, meta)
+			}
+		}
+		if len(addInt) == 0 && len(removeInt) > 0 {
+			defaults := MergeDefaultTags(meta, new(schema.Set))
+			if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+				for _, t := range strings.Split(v, ",") {
+					defaults.Add(t)
+				}
+			}
+			suppress := true
+			for _, t := range removeInt {
+				if !defaults.Contains(t) {
+					suppress = false
+					break
+				}
+			}
+			if suppress {
+				log.Printf("[DEBUG] Suppressing the diff of the provider attached tags %v", removeInt)
+				if err := diff.Clear("tags"); err != nil {
+					return err
+				}
 			}
 		}
 	}
-	return nil
+	return resourceTagsAllCustomizeDiff(diff, meta)
+}
+
+// resourceTagsAllCustomizeDiff plans tags_all as the configured tags merged with
+// the provider default_tags. Resources without a tags_all attribute are left
+// untouched.
+func resourceTagsAllCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	current, ok := diff.Get("tags_all").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+	tags, _ := diff.Get("tags").(*schema.Set)
+	if tags == nil {
+		tags = new(schema.Set)
+	}
+	tagsAll := NewStringSet(ResourceIBMVPCHash, RemoveIgnoredTags(meta, ExpandStringList(MergeDefaultTags(meta, tags).List())))
+	if current.Equal(tagsAll) {
+		return nil
+	}
+	return diff.SetNew("tags_all", tagsAll)
+}
+
+// TagsAllSchema is the computed tags_all attribute of the resources that use
+// ResourceTagsCustomizeDiff: the tags of the resource including the provider
+// default_tags.
+func TagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         ResourceIBMVPCHash,
+		Description: "List of tags of the resource, including the provider default_tags",
+	}
+}
+
+// HasDefaultTags reports whether the provider sets default_tags, which are
+// attached on creation even to the resources that set no tags.
+func HasDefaultTags(meta interface{}) bool {
+	sess, ok := meta.(conns.ClientSession)
+	return ok && len(sess.DefaultTags()) > 0
+}
+
+// MergeDefaultTags returns the union of tags and the provider default_tags.
+func MergeDefaultTags(meta interface{}, tags *schema.Set) *schema.Set {
+	merged := NewStringSet(ResourceIBMVPCHash, ExpandStringList(tags.List()))
+	if sess, ok := meta.(conns.ClientSession); ok {
+		for _, t := range sess.DefaultTags() {
+			merged.Add(t)
+		}
+	}
+	return merged
+}
+
+// RemoveIgnoredTags drops the tags whose key matches one of the provider
+// ignore_tags key prefixes.
+func RemoveIgnoredTags(meta interface{}, tags []string) []string {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok || len(sess.IgnoreTagPrefixes()) == 0 {
+		return tags
+	}
+	var kept []string
+	for _, t := range tags {
+		ignored := false
+		for _, prefix := range sess.IgnoreTagPrefixes() {
+			if strings.HasPrefix(strings.TrimSpace(t), prefix) {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			kept = append(kept, t)
+		}
+	}
+	return kept
 }
 
 func ResourceLBListenerPolicyCustomizeDiff(diff *schema.ResourceDiff) error {
@@ -2676,24 +2788,25 @@ func FlattenSatelliteZones(zones *schema.Set) []string {
 
 // error object
 type ServiceErrorResponse struct {
-	Message    string
-	StatusCode int
-	Result     interface{}
+	Message       string
+	StatusCode    int
+	TransactionID string           `json:",omitempty"`
+	Errors        []APIErrorDetail `json:",omitempty"`
+	Result        interface{}
 }
 
 func BeautifyError(err error, response *core.DetailedResponse) *ServiceErrorResponse {
-	var (
-		statusCode int
-		result     interface{}
-	)
+	apiErr := NewAPIError("", "", err, response)
+	var result interface{}
 	if response != nil {
-		statusCode = response.StatusCode
 		result = response.Result
 	}
 	return &ServiceErrorResponse{
-		Message:    err.Error(),
-		StatusCode: statusCode,
-		Result:     result,
+		Message:       apiErr.Message,
+		StatusCode:    apiErr.StatusCode,
+		TransactionID: apiErr.TransactionID,
+		Errors:        apiErr.Errors,
+		Result:        result,
 	}
 }
 
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package fwprovider is the part of the IBM Cloud provider written on
+// terraform-plugin-framework. It is served together with the SDKv2 provider
+// by a mux server, so that new resources and data sources can be written on
+// the framework and the existing ones migrated one service at a time.
+//
+// The provider configuration belongs to the SDKv2 provider: the schema of the
+// framework provider is derived from it, and the framework resources and data
+// sources get the conns.ClientSession configured by the SDKv2 provider as the
+// ProviderData of their Configure request.
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resources lists the constructors of the resources written on the
+// framework. A resource moved to the framework must be removed from the
+// ResourcesMap of the SDKv2 provider, each resource type is served by a single
+// provider.
+var Resources = []func() resource.Resource{}
+
+// DataSources lists the constructors of the data sources written on the
+// framework.
+var DataSources = []func() datasource.DataSource{}
+
+// frameworkProvider serves the framework resources and data sources with the
+// configuration of the SDKv2 provider.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+// New returns the framework provider paired with sdkProvider, the one served
+// by the same mux server.
+func New(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+// ProtoV5ProviderServerFactory returns the server muxing sdkProvider and the
+// framework provider.
+func ProtoV5ProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	servers := []func() tfprotov5.ProviderServer{
+		// The SDKv2 provider must come first, it is configured before the
+		// framework provider
+		sdkProvider.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return configValidatedServer{providerserver.NewProtocol5(New(sdkProvider))()}
+		},
+	}
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// configValidatedServer is a server whose provider configuration is already
+// validated by the SDKv2 provider. Its validation diagnostics are dropped,
+// they would be reported twice.
+type configValidatedServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s configValidatedServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	return &tfprotov5.PrepareProviderConfigResponse{}, nil
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ibm"
+	resp.Version = version.Version
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	s, err := providerSchema(ctx, p.sdkProvider)
+	if err != nil {
+		resp.Diagnostics.AddError("Error converting the provider schema", err.Error())
+		return
+	}
+	resp.Schema = s
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// The mux server configures the SDKv2 provider first and stops on error
+	meta := p.sdkProvider.Meta()
+	if meta == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The IBM Cloud session must be configured by the SDKv2 provider before the framework provider")
+		return
+	}
+	resp.DataSourceData = meta
+	resp.ResourceData = meta
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return Resources
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return DataSources
+}
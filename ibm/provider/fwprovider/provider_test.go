@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testSDKProvider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The API key",
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "us-south",
+			},
+			"timeout": {
+				Type:       schema.TypeInt,
+				Optional:   true,
+				Deprecated: "Use retry",
+			},
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The retry policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"retryable_status_codes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"ibm_sdk": {
+				ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					d.SetId("sdk")
+					return nil
+				},
+				Schema: map[string]*schema.Schema{},
+			},
+		},
+		ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return "session of " + d.Get("region").(string), nil
+		},
+	}
+}
+
+// testDataSource records the ProviderData it is configured with.
+type testDataSource struct {
+	providerData *interface{}
+}
+
+func (d testDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_framework"
+}
+
+func (d testDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (d testDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		*d.providerData = req.ProviderData
+	}
+}
+
+func (d testDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+}
+
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	var providerData interface{}
+	defer func(dataSources []func() datasource.DataSource) { DataSources = dataSources }(DataSources)
+	DataSources = []func() datasource.DataSource{
+		func() datasource.DataSource { return testDataSource{providerData: &providerData} },
+	}
+
+	ctx := context.Background()
+	sdkProvider := testSDKProvider()
+	factory, err := ProtoV5ProviderServerFactory(ctx, sdkProvider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range schemaResp.Diagnostics {
+		t.Errorf("Unexpected schema diagnostic %s: %s", d.Summary, d.Detail)
+	}
+	for _, name := range []string{"ibm_sdk", "ibm_framework"} {
+		if _, ok := schemaResp.DataSourceSchemas[name]; !ok {
+			t.Errorf("Expected the data source %s to be served", name)
+		}
+	}
+
+	configType := schemaResp.Provider.ValueType()
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, map[string]tftypes.Value{
+		"api_key":      tftypes.NewValue(tftypes.String, nil),
+		"region":       tftypes.NewValue(tftypes.String, "eu-de"),
+		"timeout":      tftypes.NewValue(tftypes.Number, 10),
+		"default_tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		"labels":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"retry":        tftypes.NewValue(configType.(tftypes.Object).AttributeTypes["retry"], nil),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The deprecation warning is reported once, by the SDKv2 provider
+	prepareResp, err := server.PrepareProviderConfig(ctx, &tfprotov5.PrepareProviderConfigRequest{Config: &config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prepareResp.Diagnostics) != 1 || prepareResp.Diagnostics[0].Severity != tfprotov5.DiagnosticSeverityWarning {
+		t.Errorf("Expected a single deprecation warning, got %v", prepareResp.Diagnostics)
+	}
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		t.Errorf("Unexpected configure diagnostic %s: %s", d.Summary, d.Detail)
+	}
+
+	dataSourceType := schemaResp.DataSourceSchemas["ibm_framework"].ValueType()
+	dataSourceConfig, _ := tfprotov5.NewDynamicValue(dataSourceType, tftypes.NewValue(dataSourceType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, nil),
+	}))
+	readResp, err := server.ReadDataSource(ctx, &tfprotov5.ReadDataSourceRequest{TypeName: "ibm_framework", Config: &dataSourceConfig})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range readResp.Diagnostics {
+		t.Errorf("Unexpected read diagnostic %s: %s", d.Summary, d.Detail)
+	}
+	if providerData != "session of eu-de" {
+		t.Errorf("Expected the framework data source to get the session of the SDKv2 provider, got %v", providerData)
+	}
+}
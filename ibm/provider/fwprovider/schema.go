@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The mux server only compares whether the attributes are deprecated, the
+// deprecation warnings are reported by the SDKv2 provider.
+const deprecationMessage = "Deprecated, see the documentation of the argument"
+
+// providerSchema returns the schema of the SDKv2 provider as a framework
+// schema. The mux server requires the schemas of both providers to be the
+// same, deriving one from the other keeps them in sync.
+func providerSchema(ctx context.Context, sdkProvider *sdkschema.Provider) (schema.Schema, error) {
+	resp, err := sdkProvider.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return schema.Schema{}, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return schema.Schema{}, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	block := resp.Provider.Block
+	attributes, blocks, err := schemaBlock(block)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+	s := schema.Schema{
+		Attributes:  attributes,
+		Blocks:      blocks,
+		Description: block.Description,
+	}
+	if block.Deprecated {
+		s.DeprecationMessage = deprecationMessage
+	}
+	return s, nil
+}
+
+func schemaBlock(block *tfprotov5.SchemaBlock) (map[string]schema.Attribute, map[string]schema.Block, error) {
+	attributes := make(map[string]schema.Attribute, len(block.Attributes))
+	for _, a := range block.Attributes {
+		attribute, err := schemaAttribute(a)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", a.Name, err)
+		}
+		attributes[a.Name] = attribute
+	}
+	blocks := make(map[string]schema.Block, len(block.BlockTypes))
+	for _, b := range block.BlockTypes {
+		nested, err := schemaNestedBlock(b)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", b.TypeName, err)
+		}
+		blocks[b.TypeName] = nested
+	}
+	return attributes, blocks, nil
+}
+
+func schemaNestedBlock(b *tfprotov5.SchemaNestedBlock) (schema.Block, error) {
+	attributes, blocks, err := schemaBlock(b.Block)
+	if err != nil {
+		return nil, err
+	}
+	object := schema.NestedBlockObject{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+	var deprecation string
+	if b.Block.Deprecated {
+		deprecation = deprecationMessage
+	}
+	switch b.Nesting {
+	case tfprotov5.SchemaNestedBlockNestingModeList:
+		return schema.ListNestedBlock{
+			NestedObject:       object,
+			Description:        b.Block.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return schema.SetNestedBlock{
+			NestedObject:       object,
+			Description:        b.Block.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case tfprotov5.SchemaNestedBlockNestingModeSingle:
+		return schema.SingleNestedBlock{
+			Attributes:         attributes,
+			Blocks:             blocks,
+			Description:        b.Block.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported nesting mode %s", b.Nesting)
+}
+
+func schemaAttribute(a *tfprotov5.SchemaAttribute) (schema.Attribute, error) {
+	if a.Computed {
+		return nil, fmt.Errorf("the provider arguments can't be computed")
+	}
+	var deprecation string
+	if a.Deprecated {
+		deprecation = deprecationMessage
+	}
+	switch {
+	case a.Type.Is(tftypes.String):
+		return schema.StringAttribute{
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Sensitive:          a.Sensitive,
+			Description:        a.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case a.Type.Is(tftypes.Number):
+		return schema.NumberAttribute{
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Sensitive:          a.Sensitive,
+			Description:        a.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case a.Type.Is(tftypes.Bool):
+		return schema.BoolAttribute{
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Sensitive:          a.Sensitive,
+			Description:        a.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	}
+
+	t, err := attrType(a.Type)
+	if err != nil {
+		return nil, err
+	}
+	switch t := t.(type) {
+	case types.ListType:
+		return schema.ListAttribute{
+			ElementType:        t.ElemType,
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Sensitive:          a.Sensitive,
+			Description:        a.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case types.SetType:
+		return schema.SetAttribute{
+			ElementType:        t.ElemType,
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Sensitive:          a.Sensitive,
+			Description:        a.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case types.MapType:
+		return schema.MapAttribute{
+			ElementType:        t.ElemType,
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Sensitive:          a.Sensitive,
+			Description:        a.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	case types.ObjectType:
+		return schema.ObjectAttribute{
+			AttributeTypes:     t.AttrTypes,
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Sensitive:          a.Sensitive,
+			Description:        a.Description,
+			DeprecationMessage: deprecation,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", a.Type)
+}
+
+func attrType(t tftypes.Type) (attr.Type, error) {
+	switch {
+	case t.Is(tftypes.String):
+		return types.StringType, nil
+	case t.Is(tftypes.Number):
+		return types.NumberType, nil
+	case t.Is(tftypes.Bool):
+		return types.BoolType, nil
+	}
+	switch t := t.(type) {
+	case tftypes.List:
+		elem, err := attrType(t.ElementType)
+		return types.ListType{ElemType: elem}, err
+	case tftypes.Set:
+		elem, err := attrType(t.ElementType)
+		return types.SetType{ElemType: elem}, err
+	case tftypes.Map:
+		elem, err := attrType(t.ElementType)
+		return types.MapType{ElemType: elem}, err
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type, len(t.AttributeTypes))
+		for name, attributeType := range t.AttributeTypes {
+			converted, err := attrType(attributeType)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[name] = converted
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
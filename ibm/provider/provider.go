@@ -4,13 +4,18 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -54,7 +59,7 @@ import (
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -185,6 +190,20 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"iam_cr_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "File of the compute resource token used to assume the trusted profile iam_profile_id",
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_CR_TOKEN_FILE", "IBMCLOUD_IAM_CR_TOKEN_FILE"}, nil),
+				ConflictsWith: []string{"iam_use_instance_metadata"},
+			},
+			"iam_use_instance_metadata": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Description:   "Get the IAM token of the trusted profile from the VPC instance metadata service",
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_USE_INSTANCE_METADATA", "IBMCLOUD_IAM_USE_INSTANCE_METADATA"}, false),
+				ConflictsWith: []string{"iam_cr_token_file"},
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -210,6 +229,75 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy shared by all the API clients",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Total number of attempts of an API call, including the first one. Defaults to max_retries + 1",
+						},
+						"min_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      conns.DefaultRetryMinDelay.String(),
+							ValidateFunc: validateRetryDelay,
+							Description:  "Minimum delay between two attempts, for example 500ms or 2s",
+						},
+						"max_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      conns.DefaultRetryMaxDelay.String(),
+							ValidateFunc: validateRetryDelay,
+							Description:  "Maximum delay between two attempts, Retry-After headers are capped to this value",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+							Description: "HTTP status codes that are retried",
+						},
+					},
+				},
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Custom service endpoints, these take precedence over the endpoints file",
+				Elem: &schema.Resource{
+					Schema: endpointsSchema(),
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Tags attached to every taggable resource managed by the provider",
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are neither read nor updated by the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag key prefixes to ignore, for example `env:` ignores every tag whose key starts with env",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -350,6 +438,10 @@ func Provider() *schema.Provider {
 			"ibm_is_bare_metal_server":                                vpc.DataSourceIBMIsBareMetalServer(),
 			"ibm_is_bare_metal_servers":                               vpc.DataSourceIBMIsBareMetalServers(),
 
+			// backup_policy
+			"ibm_is_backup_policies":     vpc.DataSourceIBMIsBackupPolicies(),
+			"ibm_is_backup_policy_plans": vpc.DataSourceIBMIsBackupPolicyPlans(),
+
 			"ibm_is_dedicated_host":                  vpc.DataSourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_hosts":                 vpc.DataSourceIbmIsDedicatedHosts(),
 			"ibm_is_dedicated_host_profile":          vpc.DataSourceIbmIsDedicatedHostProfile(),
@@ -418,6 +510,7 @@ func Provider() *schema.Provider {
 			"ibm_is_region":                      vpc.DataSourceIBMISRegion(),
 			"ibm_is_regions":                     vpc.DataSourceIBMISRegions(),
 			"ibm_is_ssh_key":                     vpc.DataSourceIBMISSSHKey(),
+			"ibm_is_ssh_keys":                    vpc.DataSourceIBMIsSSHKeys(),
 			"ibm_is_subnet":                      vpc.DataSourceIBMISSubnet(),
 			"ibm_is_subnets":                     vpc.DataSourceIBMISSubnets(),
 			"ibm_is_subnet_reserved_ip":          vpc.DataSourceIBMISReservedIP(),
@@ -433,6 +526,7 @@ func Provider() *schema.Provider {
 			"ibm_is_volume":                      vpc.DataSourceIBMISVolume(),
 			"ibm_is_volume_profile":              vpc.DataSourceIBMISVolumeProfile(),
 			"ibm_is_volume_profiles":             vpc.DataSourceIBMISVolumeProfiles(),
+			"ibm_is_volumes":                     vpc.DataSourceIBMIsVolumes(),
 			"ibm_is_vpc":                         vpc.DataSourceIBMISVPC(),
 			"ibm_is_vpcs":                        vpc.DataSourceIBMISVPCs(),
 			"ibm_is_vpn_gateway":                 vpc.DataSourceIBMISVPNGateway(),
@@ -441,11 +535,14 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_address_prefix":          vpc.DataSourceIBMIsVPCAddressPrefix(),
 			"ibm_is_vpn_gateway_connection":      vpc.DataSourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpn_gateway_connections":     vpc.DataSourceIBMISVPNGatewayConnections(),
+			"ibm_is_vpn_server_client":           vpc.DataSourceIBMIsVPNServerClient(),
+			"ibm_is_vpn_server_clients":          vpc.DataSourceIBMIsVPNServerClients(),
 			"ibm_is_vpc_default_routing_table":   vpc.DataSourceIBMISVPCDefaultRoutingTable(),
 			"ibm_is_vpc_routing_table":           vpc.DataSourceIBMIBMIsVPCRoutingTable(),
 			"ibm_is_vpc_routing_tables":          vpc.DataSourceIBMISVPCRoutingTables(),
 			"ibm_is_vpc_routing_table_route":     vpc.DataSourceIBMIBMIsVPCRoutingTableRoute(),
 			"ibm_is_vpc_routing_table_routes":    vpc.DataSourceIBMISVPCRoutingTableRoutes(),
+			"ibm_is_vpc_topology":                vpc.DataSourceIBMIsVPCTopology(),
 			"ibm_is_zone":                        vpc.DataSourceIBMISZone(),
 			"ibm_is_zones":                       vpc.DataSourceIBMISZones(),
 			"ibm_is_operating_system":            vpc.DataSourceIBMISOperatingSystem(),
@@ -454,6 +551,7 @@ func Provider() *schema.Provider {
 			"ibm_is_network_acl":                 vpc.DataSourceIBMIsNetworkACL(),
 			"ibm_is_network_acl_rule":            vpc.DataSourceIBMISNetworkACLRule(),
 			"ibm_is_network_acl_rules":           vpc.DataSourceIBMISNetworkACLRules(),
+			"ibm_is_network_acl_analysis":        vpc.DataSourceIBMIsNetworkACLAnalysis(),
 			"ibm_lbaas":                          classicinfrastructure.DataSourceIBMLbaas(),
 			"ibm_network_vlan":                   classicinfrastructure.DataSourceIBMNetworkVlan(),
 			"ibm_org":                            cloudfoundry.DataSourceIBMOrg(),
@@ -722,10 +820,15 @@ func Provider() *schema.Provider {
 			"ibm_container_vpc_cluster":                 kubernetes.ResourceIBMContainerVpcCluster(),
 			"ibm_container_alb_cert":                    kubernetes.ResourceIBMContainerALBCert(),
 			"ibm_container_cluster":                     kubernetes.ResourceIBMContainerCluster(),
+			"ibm_container_cluster_autoscaler":          kubernetes.ResourceIBMContainerClusterAutoscaler(),
 			"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeature(),
+			"ibm_container_ingress_instance":            kubernetes.ResourceIBMContainerIngressInstance(),
+			"ibm_container_ingress_secret_opaque":       kubernetes.ResourceIBMContainerIngressSecretOpaque(),
+			"ibm_container_ingress_secret_tls":          kubernetes.ResourceIBMContainerIngressSecretTLS(),
 			"ibm_container_bind_service":                kubernetes.ResourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                 kubernetes.ResourceIBMContainerWorkerPool(),
 			"ibm_container_worker_pool_zone_attachment": kubernetes.ResourceIBMContainerWorkerPoolZoneAttachment(),
+			"ibm_container_worker_action":               kubernetes.ResourceIBMContainerWorkerAction(),
 			"ibm_container_storage_attachment":          kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachment(),
 			"ibm_container_nlb_dns":                     kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_cr_namespace":                          registry.ResourceIBMCrNamespace(),
@@ -772,6 +875,10 @@ func Provider() *schema.Provider {
 			"ibm_is_bare_metal_server_network_interface":             vpc.ResourceIBMIsBareMetalServerNetworkInterface(),
 			"ibm_is_bare_metal_server":                               vpc.ResourceIBMIsBareMetalServer(),
 
+			// backup_policy
+			"ibm_is_backup_policy":      vpc.ResourceIBMIsBackupPolicy(),
+			"ibm_is_backup_policy_plan": vpc.ResourceIBMIsBackupPolicyPlan(),
+
 			"ibm_is_dedicated_host":                              vpc.ResourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_host_group":                        vpc.ResourceIbmIsDedicatedHostGroup(),
 			"ibm_is_dedicated_host_disk_management":              vpc.ResourceIBMISDedicatedHostDiskManagement(),
@@ -799,6 +906,7 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener_policy_rule":                     vpc.ResourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                     vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                              vpc.ResourceIBMISLBPoolMember(),
+			"ibm_is_lb_pool_members":                             vpc.ResourceIBMISLBPoolMembers(),
 			"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRule(),
 			"ibm_is_public_gateway":                              vpc.ResourceIBMISPublicGateway(),
@@ -816,6 +924,9 @@ func Provider() *schema.Provider {
 			"ibm_is_volume":                                      vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                                 vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                      vpc.ResourceIBMISVPNGatewayConnection(),
+			"ibm_is_vpn_server":                                  vpc.ResourceIBMIsVPNServer(),
+			"ibm_is_vpn_server_client":                           vpc.ResourceIBMIsVPNServerClient(),
+			"ibm_is_vpn_server_route":                            vpc.ResourceIBMIsVPNServerRoute(),
 			"ibm_is_vpc":                                         vpc.ResourceIBMISVPC(),
 			"ibm_is_vpc_address_prefix":                          vpc.ResourceIBMISVpcAddressPrefix(),
 			"ibm_is_vpc_route":                                   vpc.ResourceIBMISVpcRoute(),
@@ -979,8 +1090,55 @@ func Provider() *schema.Provider {
 			"ibm_en_subscription_ios":     eventnotification.ResourceIBMEnFCMSubscription(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
+	}
+	return p
+}
+
+func validateRetryDelay(v interface{}, k string) (ws []string, errors []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 500ms or 2s: %s", k, err))
+	} else if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got %s", k, v))
+	}
+	return
+}
+
+func expandRetryPolicy(l []interface{}) conns.RetryPolicy {
+	policy := conns.RetryPolicy{}
+	if len(l) == 0 || l[0] == nil {
+		return policy
+	}
+	retry := l[0].(map[string]interface{})
+	policy.MaxAttempts = retry["max_attempts"].(int)
+	// validated by validateRetryDelay
+	policy.MinDelay, _ = time.ParseDuration(retry["min_delay"].(string))
+	policy.MaxDelay, _ = time.ParseDuration(retry["max_delay"].(string))
+	for _, code := range retry["retryable_status_codes"].([]interface{}) {
+		policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+	}
+	return policy
+}
+
+func expandIgnoreTagPrefixes(l []interface{}) []string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	return flex.ExpandStringList(l[0].(map[string]interface{})["key_prefixes"].(*schema.Set).List())
+}
+
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := make(map[string]*schema.Schema, len(conns.ServiceEndpointKeys))
+	for name, key := range conns.ServiceEndpointKeys {
+		endpoints[name] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("Custom endpoint URL, same as the %s endpoint variable", key),
+		}
 	}
+	return endpoints
 }
 
 var globalValidatorDict validate.ValidatorDict
@@ -1039,6 +1197,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_bare_metal_server_disk":              vpc.ResourceIBMIsBareMetalServerDiskValidator(),
 				"ibm_is_bare_metal_server_network_interface": vpc.ResourceIBMIsBareMetalServerNetworkInterfaceValidator(),
 				"ibm_is_bare_metal_server":                   vpc.ResourceIBMIsBareMetalServerValidator(),
+				"ibm_is_backup_policy":                       vpc.ResourceIBMIsBackupPolicyValidator(),
+				"ibm_is_backup_policy_plan":                  vpc.ResourceIBMIsBackupPolicyPlanValidator(),
 
 				"ibm_is_dedicated_host_group":             vpc.ResourceIbmIsDedicatedHostGroupValidator(),
 				"ibm_is_dedicated_host":                   vpc.ResourceIbmIsDedicatedHostValidator(),
@@ -1063,6 +1223,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_lb_listener_policy":               vpc.ResourceIBMISLBListenerPolicyValidator(),
 				"ibm_is_lb_listener":                      vpc.ResourceIBMISLBListenerValidator(),
 				"ibm_is_lb_pool_member":                   vpc.ResourceIBMISLBPoolMemberValidator(),
+				"ibm_is_lb_pool_members":                  vpc.ResourceIBMISLBPoolMembersValidator(),
 				"ibm_is_lb_pool":                          vpc.ResourceIBMISLBPoolValidator(),
 				"ibm_is_lb":                               vpc.ResourceIBMISLBValidator(),
 				"ibm_is_network_acl":                      vpc.ResourceIBMISNetworkACLValidator(),
@@ -1084,6 +1245,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_vpc_routing_table_route":          vpc.ResourceIBMISVPCRoutingTableRouteValidator(),
 				"ibm_is_vpn_gateway_connection":           vpc.ResourceIBMISVPNGatewayConnectionValidator(),
 				"ibm_is_vpn_gateway":                      vpc.ResourceIBMISVPNGatewayValidator(),
+				"ibm_is_vpn_server":                       vpc.ResourceIBMIsVPNServerValidator(),
+				"ibm_is_vpn_server_route":                 vpc.ResourceIBMIsVPNServerRouteValidator(),
 				"ibm_kms_key_rings":                       kms.ResourceIBMKeyRingValidator(),
 				"ibm_dns_glb_monitor":                     dnsservices.ResourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_glb_pool":                        dnsservices.ResourceIBMPrivateDNSGLBPoolValidator(),
@@ -1140,7 +1303,7 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId string
@@ -1197,8 +1360,21 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		visibility = v.(string)
 	}
 	var file string
+	var diags diag.Diagnostics
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
+		_, diags = conns.ReadEndpointsFile(file)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	endpoints := map[string]string{}
+	if l, ok := d.GetOk("endpoints"); ok && len(l.([]interface{})) > 0 && l.([]interface{})[0] != nil {
+		for name, url := range l.([]interface{})[0].(map[string]interface{}) {
+			if url.(string) != "" {
+				endpoints[conns.ServiceEndpointKeys[name]] = url.(string)
+			}
+		}
 	}
 
 	resourceGrp := d.Get("resource_group").(string)
@@ -1210,7 +1386,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
-		return nil, err
+		return nil, append(diags, diag.FromErr(err)...)
 	}
 	//Set environment variable to be used in DiffSupressFunction
 	if wskEnvVal.(string) == "" {
@@ -1218,25 +1394,35 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := conns.Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
-		EndpointsFile:        file,
-		IAMTrustedProfileID:  iamTrustedProfileId,
+		BluemixAPIKey:          bluemixAPIKey,
+		Region:                 region,
+		ResourceGroup:          resourceGrp,
+		BluemixTimeout:         time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:       time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:      softlayerUsername,
+		SoftLayerAPIKey:        softlayerAPIKey,
+		RetryCount:             retryCount,
+		SoftLayerEndpointURL:   softlayerEndpointUrl,
+		RetryDelay:             conns.RetryAPIDelay,
+		FunctionNameSpace:      wskNameSpace,
+		RiaasEndPoint:          riaasEndPoint,
+		IAMToken:               iamToken,
+		IAMRefreshToken:        iamRefreshToken,
+		Zone:                   zone,
+		Visibility:             visibility,
+		EndpointsFile:          file,
+		Endpoints:              endpoints,
+		RetryPolicy:            expandRetryPolicy(d.Get("retry").([]interface{})),
+		IAMTrustedProfileID:    iamTrustedProfileId,
+		IAMCRTokenFile:         d.Get("iam_cr_token_file").(string),
+		IAMUseInstanceMetadata: d.Get("iam_use_instance_metadata").(bool),
+		DefaultTags:            flex.ExpandStringList(d.Get("default_tags").(*schema.Set).List()),
+		IgnoreTagPrefixes:      expandIgnoreTagPrefixes(d.Get("ignore_tags").([]interface{})),
 	}
 
-	return config.ClientSession()
+	session, err := config.ClientSession()
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return session, diags
 }
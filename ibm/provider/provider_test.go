@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+// TestValidatorDict checks the validators the resource and data source
+// schemas look up while the provider is built.
+func TestValidatorDict(t *testing.T) {
+	Provider()
+	problems, unused := validate.ValidatorDictProblems()
+	for _, problem := range problems {
+		t.Error(problem)
+	}
+	for _, validator := range unused {
+		t.Log(validator)
+	}
+}
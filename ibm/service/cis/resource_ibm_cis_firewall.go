@@ -311,7 +311,7 @@ func ResourceIBMCISFirewallValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "block, challenge, js_challenge"})
-	cisFirewallValidator := validate.ResourceValidator{ResourceName: ibmCISHealthCheck, Schema: validateSchema}
+	cisFirewallValidator := validate.ResourceValidator{ResourceName: ibmCISFirewall, Schema: validateSchema}
 	return &cisFirewallValidator
 }
 
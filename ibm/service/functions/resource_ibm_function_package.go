@@ -141,6 +141,13 @@ func ResourceIBMFuncPackageValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Default:                    "[]",
 			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 funcPkgUsrDefParams,
+			ValidateFunctionIdentifier: validate.ValidateJSONString,
+			Type:                       validate.TypeString,
+			Default:                    "[]",
+			Optional:                   true})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 funcPkgBindPkgName,
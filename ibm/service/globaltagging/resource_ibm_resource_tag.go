@@ -40,7 +40,7 @@ func ResourceIBMResourceTag() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -59,6 +59,7 @@ func ResourceIBMResourceTag() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags associated with resource instance",
 			},
+			"tags_all": flex.TagsAllSchema(),
 			resourceType: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -137,12 +138,16 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 	r := globaltaggingv1.Resource{ResourceID: flex.PtrToString(resourceID), ResourceType: flex.PtrToString(rType)}
 	resources = append(resources, r)
 
-	var add []string
+	tagSet := new(schema.Set)
 	if v, ok := d.GetOk(tags); ok {
-		tags := v.(*schema.Set)
-		for _, t := range tags.List() {
-			add = append(add, fmt.Sprint(t))
-		}
+		tagSet = v.(*schema.Set)
+	}
+	if t := d.Get(tagType).(string); t == "" || t == "user" {
+		tagSet = flex.MergeDefaultTags(meta, tagSet)
+	}
+	var add []string
+	for _, t := range tagSet.List() {
+		add = append(add, fmt.Sprint(t))
 	}
 
 	schematicTags := os.Getenv("IC_ENV_TAGS")
@@ -151,6 +156,7 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 		envTags = strings.Split(schematicTags, ",")
 		add = append(add, envTags...)
 	}
+	add = flex.RemoveIgnoredTags(meta, add)
 
 	AttachTagOptions := &globaltaggingv1.AttachTagOptions{}
 	AttachTagOptions.Resources = resources
@@ -233,6 +239,7 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 	d.Set(resourceID, rID)
 	d.Set(resourceType, rType)
 	d.Set(tags, tagList)
+	d.Set("tags_all", tagList)
 
 	return nil
 }
@@ -260,7 +267,7 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 		tType = v.(string)
 	}
 
-	if _, ok := d.GetOk(tags); ok {
+	if _, ok := d.GetOk(tags); ok || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(tags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, rID, rType, tType)
 		if err != nil {
@@ -47,7 +47,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"units", "failover_units", "location", "resource_group_id", "service"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -103,6 +103,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_hpcs", "tag")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": flex.TagsAllSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -431,7 +432,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 
 	// Update Tags for this Resource using Global Tagging APIs
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -484,6 +485,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 			"[ERROR] Error on get of HPCS instance tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	// Set Location
 	if instance.CRN != nil {
 		location := strings.Split(*instance.CRN, ":")
@@ -633,7 +635,7 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting HPCS instance: %s with resp code: %s", err, resp))
 	}
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -4,20 +4,32 @@
 package kubernetes
 
 import (
+	"archive/zip"
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMContainerClusterConfig() *schema.Resource {
@@ -118,6 +130,62 @@ func DataSourceIBMContainerClusterConfig() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"in_memory": {
+				Description: "If set to true, the config is returned in the kubeconfig attribute and nothing is written to config_dir",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"kubeconfig": {
+				Description: "The kubeconfig YAML with the certificates embedded, when in_memory is true",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": {
+				Description: "When the admin certificate, or else the token, of the config expires",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"exec": {
+				Description: "The command which returns the credentials of the exec_kubeconfig, such as a command printing an IAM token",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Description: "The API version of the ExecCredential returned by the command",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "client.authentication.k8s.io/v1beta1",
+						},
+						"command": {
+							Description: "The command to run, by default a shell which prints the IAM token of the ibmcloud CLI session",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"args": {
+							Description: "The arguments of the command, which requires command",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"env": {
+							Description: "The environment variables of the command",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"exec_kubeconfig": {
+				Description: "The kubeconfig YAML which gets its credentials from the exec command",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -138,6 +206,31 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	conns.IbmMutexKV.Lock(clusterId)
 	defer conns.IbmMutexKV.Unlock(clusterId)
 
+	if d.Get("in_memory").(bool) {
+		if network {
+			return fmt.Errorf("[ERROR] The Calico network config can't be returned in memory, set in_memory to false")
+		}
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		kubeconfig, clusterKeyDetails, err := getClusterConfigInMemory(csClient, name, admin, targetEnv)
+		if err != nil {
+			return err
+		}
+		d.Set("kubeconfig", string(kubeconfig))
+		d.Set("admin_key", clusterKeyDetails.AdminKey)
+		d.Set("admin_certificate", clusterKeyDetails.Admin)
+		d.Set("ca_certificate", clusterKeyDetails.ClusterCACertificate)
+		d.Set("host", clusterKeyDetails.Host)
+		d.Set("token", clusterKeyDetails.Token)
+		if err := setClusterConfigAccess(d, name, clusterKeyDetails); err != nil {
+			return err
+		}
+		d.SetId(name)
+		return nil
+	}
+
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
 		if err != nil {
@@ -194,30 +287,14 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 			d.Set("host", clusterKeyDetails.Host)
 			d.Set("token", clusterKeyDetails.Token)
 			d.Set("config_file_path", clusterKeyDetails.FilePath)
+			if err := setClusterConfigAccess(d, name, clusterKeyDetails); err != nil {
+				return err
+			}
 
 		} else {
-			var clusterKeyDetails v1.ClusterKeyInfo
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				var err error
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
-				if err != nil {
-					log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
-					if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
-						return resource.RetryableError(err)
-					}
-					if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
-						// Intermittent error resulting from synchronisation delay
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if conns.IsResourceTimeoutError(err) {
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
-			}
+			clusterKeyDetails, err := getClusterConfigDetail(csAPI, name, configDir, admin, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+				return err
 			}
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
 			d.Set("admin_certificate", clusterKeyDetails.Admin)
@@ -225,6 +302,9 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 			d.Set("host", clusterKeyDetails.Host)
 			d.Set("token", clusterKeyDetails.Token)
 			d.Set("config_file_path", clusterKeyDetails.FilePath)
+			if err := setClusterConfigAccess(d, name, clusterKeyDetails); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -232,3 +312,341 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+// getClusterConfigDetail downloads the cluster config into configDir and
+// returns its details, retrying the intermittent login failures.
+func getClusterConfigDetail(csAPI v2.Clusters, name, configDir string, admin bool, targetEnv v2.ClusterTargetHeader) (v1.ClusterKeyInfo, error) {
+	var clusterKeyDetails v1.ClusterKeyInfo
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if isClusterConfigRetryableError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
+	}
+	if err != nil {
+		return clusterKeyDetails, fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+	}
+	return clusterKeyDetails, nil
+}
+
+func isClusterConfigRetryableError(err error) bool {
+	if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+		return true
+	}
+	// Intermittent error resulting from synchronisation delay
+	intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error())
+	return intermittentUserLookupFailure
+}
+
+// getClusterConfigInMemory downloads the cluster config archive into memory,
+// and returns its kubeconfig with the certificates embedded as data. Nothing
+// is written to the filesystem.
+func getClusterConfigInMemory(csClient v2.ContainerServiceAPI, name string, admin bool, targetEnv v2.ClusterTargetHeader) ([]byte, v1.ClusterKeyInfo, error) {
+	var clusterKeyDetails v1.ClusterKeyInfo
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return nil, clusterKeyDetails, err
+	}
+	clusterInfo, err := csClient.Clusters().GetCluster(name, targetEnv)
+	if err != nil {
+		return nil, clusterKeyDetails, fmt.Errorf("[ERROR] Error getting the cluster [%s]: %s", name, err)
+	}
+	postBody := map[string]interface{}{
+		"cluster": name,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if clusterInfo.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+		postBody["admin"] = true
+	}
+
+	var archive bytes.Buffer
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		archive.Reset()
+		_, err := rawClient.Post("/v2/applyRBACAndGetKubeconfig", postBody, &archive, targetEnv.ToMap())
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if isClusterConfigRetryableError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		archive.Reset()
+		_, err = rawClient.Post("/v2/applyRBACAndGetKubeconfig", postBody, &archive, targetEnv.ToMap())
+	}
+	if err != nil {
+		return nil, clusterKeyDetails, fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+	}
+
+	files, kubeconfigName, err := readClusterConfigArchive(archive.Bytes())
+	if err != nil {
+		return nil, clusterKeyDetails, fmt.Errorf("[ERROR] Error reading the cluster config [%s]: %s", name, err)
+	}
+	for fileName, content := range files {
+		switch {
+		case fileName == "admin-key.pem":
+			clusterKeyDetails.AdminKey = string(content)
+		case fileName == "admin.pem":
+			clusterKeyDetails.Admin = string(content)
+		case strings.HasPrefix(fileName, "ca") && strings.HasSuffix(fileName, ".pem"):
+			clusterKeyDetails.ClusterCACertificate = string(content)
+		}
+	}
+	kubeconfig, err := embedClusterConfigFiles(files[kubeconfigName], files)
+	if err != nil {
+		return nil, clusterKeyDetails, fmt.Errorf("[ERROR] Error reading the kubeconfig of the cluster config [%s]: %s", name, err)
+	}
+
+	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
+		// The OpenShift clusters need a token of their OAuth server, like
+		// GetClusterConfigDetail does
+		tokenFetcher, ok := csClient.Clusters().(interface {
+			FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool) ([]byte, error)
+		})
+		if !ok {
+			return nil, clusterKeyDetails, fmt.Errorf("[ERROR] The container service client can't log in to the OpenShift cluster [%s]", name)
+		}
+		kubeconfig, err = tokenFetcher.FetchOCTokenForKubeConfig(kubeconfig, clusterInfo, clusterInfo.IsStagingSatelliteCluster())
+		if err != nil {
+			return nil, clusterKeyDetails, fmt.Errorf("[ERROR] Error logging in to the OpenShift cluster [%s]: %s", name, err)
+		}
+	}
+
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(kubeconfig, &config); err != nil {
+		return nil, clusterKeyDetails, fmt.Errorf("[ERROR] Error parsing the kubeconfig of the cluster config [%s]: %s", name, err)
+	}
+	if clusters, _ := config["clusters"].([]interface{}); len(clusters) != 0 {
+		if cluster, ok := clusters[0].(map[string]interface{})["cluster"].(map[string]interface{}); ok {
+			clusterKeyDetails.Host, _ = cluster["server"].(string)
+		}
+	}
+	users, _ := config["users"].([]interface{})
+	for i, u := range users {
+		usr := u.(map[string]interface{})
+		user, _ := usr["user"].(map[string]interface{})
+		if userName, _ := usr["name"].(string); strings.HasPrefix(userName, "IAM") {
+			// The IAM user of the OpenShift clusters
+			clusterKeyDetails.Token, _ = user["token"].(string)
+			break
+		}
+		if i == 0 {
+			authProvider, _ := user["auth-provider"].(map[string]interface{})
+			authConfig, _ := authProvider["config"].(map[string]interface{})
+			clusterKeyDetails.Token, _ = authConfig["id-token"].(string)
+		}
+	}
+	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
+		clusterKeyDetails.ClusterCACertificate = ""
+	}
+	return kubeconfig, clusterKeyDetails, nil
+}
+
+// containerRawClient sends the raw requests of the container service API, for
+// the endpoints which the containerv2 client doesn't implement.
+type containerRawClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
+
+func getContainerRawClient(csClient v2.ContainerServiceAPI) (containerRawClient, error) {
+	rawClient, ok := csClient.(containerRawClient)
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] The container service client can't send raw requests")
+	}
+	return rawClient, nil
+}
+
+// readClusterConfigArchive returns the files of the cluster config archive by
+// name, and the name of its kubeconfig.
+func readClusterConfigArchive(archive []byte) (map[string][]byte, string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, "", err
+	}
+	files := make(map[string][]byte, len(reader.File))
+	var kubeconfigName string
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, "", err
+		}
+		content, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, "", err
+		}
+		fileName := filepath.Base(f.Name)
+		files[fileName] = content
+		if strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml") {
+			kubeconfigName = fileName
+		}
+	}
+	if kubeconfigName == "" {
+		return nil, "", fmt.Errorf("Unable to locate kube config in zip archive")
+	}
+	return files, kubeconfigName, nil
+}
+
+// embedClusterConfigFiles replaces the certificate files referenced by the
+// kubeconfig with their data.
+func embedClusterConfigFiles(kubeconfig []byte, files map[string][]byte) ([]byte, error) {
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(kubeconfig, &config); err != nil {
+		return nil, err
+	}
+	embed := func(section map[string]interface{}, key string) {
+		fileName, ok := section[key].(string)
+		if !ok {
+			return
+		}
+		if content, ok := files[filepath.Base(fileName)]; ok {
+			delete(section, key)
+			section[key+"-data"] = base64.StdEncoding.EncodeToString(content)
+		}
+	}
+	clusters, _ := config["clusters"].([]interface{})
+	for _, c := range clusters {
+		if cluster, ok := c.(map[string]interface{})["cluster"].(map[string]interface{}); ok {
+			embed(cluster, "certificate-authority")
+		}
+	}
+	users, _ := config["users"].([]interface{})
+	for _, u := range users {
+		if user, ok := u.(map[string]interface{})["user"].(map[string]interface{}); ok {
+			embed(user, "client-certificate")
+			embed(user, "client-key")
+		}
+	}
+	return yaml.Marshal(config)
+}
+
+// setClusterConfigAccess sets the expiry of the cluster config, and the
+// kubeconfig which gets its credentials from the exec command.
+func setClusterConfigAccess(d *schema.ResourceData, name string, clusterKeyDetails v1.ClusterKeyInfo) error {
+	if expiresAt, ok := clusterConfigExpiry(clusterKeyDetails); ok {
+		d.Set("expires_at", expiresAt.UTC().Format(time.RFC3339))
+	} else {
+		d.Set("expires_at", nil)
+	}
+
+	l, ok := d.GetOk("exec")
+	if !ok || len(l.([]interface{})) == 0 {
+		d.Set("exec_kubeconfig", nil)
+		return nil
+	}
+	// An empty exec block uses the default command
+	exec := map[string]interface{}{
+		"api_version": "client.authentication.k8s.io/v1beta1",
+		"command":     "",
+		"args":        []interface{}{},
+		"env":         map[string]interface{}{},
+	}
+	if l.([]interface{})[0] != nil {
+		exec = l.([]interface{})[0].(map[string]interface{})
+	}
+	apiVersion := exec["api_version"].(string)
+	command := exec["command"].(string)
+	args := flex.ExpandStringList(exec["args"].([]interface{}))
+	if command == "" {
+		if len(args) > 0 {
+			return fmt.Errorf("[ERROR] The args of exec require its command")
+		}
+		command, args = iamExecCommand(apiVersion)
+	}
+	execConfig := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"command":    command,
+	}
+	if len(args) > 0 {
+		execConfig["args"] = args
+	}
+	if env := exec["env"].(map[string]interface{}); len(env) > 0 {
+		names := make([]string, 0, len(env))
+		for envName := range env {
+			names = append(names, envName)
+		}
+		sort.Strings(names)
+		envVars := make([]map[string]string, 0, len(names))
+		for _, envName := range names {
+			envVars = append(envVars, map[string]string{"name": envName, "value": env[envName].(string)})
+		}
+		execConfig["env"] = envVars
+	}
+	cluster := map[string]interface{}{
+		"server": clusterKeyDetails.Host,
+	}
+	if clusterKeyDetails.ClusterCACertificate != "" {
+		cluster["certificate-authority-data"] = base64.StdEncoding.EncodeToString([]byte(clusterKeyDetails.ClusterCACertificate))
+	}
+	execKubeconfig, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Config",
+		"clusters": []interface{}{
+			map[string]interface{}{"name": name, "cluster": cluster},
+		},
+		"users": []interface{}{
+			map[string]interface{}{"name": name + "-exec", "user": map[string]interface{}{"exec": execConfig}},
+		},
+		"contexts": []interface{}{
+			map[string]interface{}{"name": name, "context": map[string]interface{}{"cluster": name, "user": name + "-exec"}},
+		},
+		"current-context": name,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error generating the exec kubeconfig: %s", err)
+	}
+	d.Set("exec_kubeconfig", string(execKubeconfig))
+	return nil
+}
+
+// iamExecCommand returns the default exec command, which prints the IAM token
+// of the ibmcloud CLI session as an ExecCredential of apiVersion.
+func iamExecCommand(apiVersion string) (string, []string) {
+	script := `token=$(ibmcloud iam oauth-tokens --output json | sed -n 's/.*"iam_token": *"Bearer \([^"]*\)".*/\1/p') && ` +
+		`[ -n "$token" ] && ` +
+		fmt.Sprintf(`printf '{"apiVersion":"%s","kind":"ExecCredential","status":{"token":"%%s"}}' "$token"`, apiVersion)
+	return "sh", []string{"-c", script}
+}
+
+// clusterConfigExpiry returns when the admin certificate of the config
+// expires, or else when its token expires.
+func clusterConfigExpiry(clusterKeyDetails v1.ClusterKeyInfo) (time.Time, bool) {
+	if block, _ := pem.Decode([]byte(clusterKeyDetails.Admin)); block != nil {
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			return cert.NotAfter, true
+		}
+	}
+	// The token is a JWT, whose payload has the exp claim
+	if parts := strings.Split(clusterKeyDetails.Token, "."); len(parts) == 3 {
+		payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+		if err != nil {
+			return time.Time{}, false
+		}
+		var claims struct {
+			Exp int64 `json:"exp"`
+		}
+		if err := json.Unmarshal(payload, &claims); err == nil && claims.Exp > 0 {
+			return time.Unix(claims.Exp, 0), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+)
+
+func clusterConfigArchive(t *testing.T, files map[string]string) []byte {
+	var archive bytes.Buffer
+	w := zip.NewWriter(&archive)
+	if _, err := w.Create("kubeConfig123/"); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return archive.Bytes()
+}
+
+func TestReadClusterConfigArchive(t *testing.T) {
+	archive := clusterConfigArchive(t, map[string]string{
+		"kubeConfig123/kube-config-fra02-mycluster.yml": "apiVersion: v1",
+		"kubeConfig123/ca-fra02-mycluster.pem":          "ca",
+		"kubeConfig123/admin.pem":                       "cert",
+	})
+	files, kubeconfigName, err := readClusterConfigArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kubeconfigName != "kube-config-fra02-mycluster.yml" {
+		t.Errorf("Expected the kubeconfig kube-config-fra02-mycluster.yml, got %s", kubeconfigName)
+	}
+	expected := map[string][]byte{
+		"kube-config-fra02-mycluster.yml": []byte("apiVersion: v1"),
+		"ca-fra02-mycluster.pem":          []byte("ca"),
+		"admin.pem":                       []byte("cert"),
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("Expected the files %q, got %q", expected, files)
+	}
+
+	_, _, err = readClusterConfigArchive(clusterConfigArchive(t, map[string]string{"kubeConfig123/admin.pem": "cert"}))
+	if err == nil || !strings.Contains(err.Error(), "Unable to locate kube config") {
+		t.Errorf("Expected the missing kubeconfig to fail, got %v", err)
+	}
+
+	if _, _, err := readClusterConfigArchive([]byte("not a zip")); err == nil {
+		t.Error("Expected an invalid archive to fail")
+	}
+}
+
+func TestEmbedClusterConfigFiles(t *testing.T) {
+	kubeconfig := []byte(`apiVersion: v1
+clusters:
+- name: mycluster
+  cluster:
+    certificate-authority: /home/user/.bluemix/plugins/kubeConfig123/ca-fra02-mycluster.pem
+    server: https://c1.eu-de.containers.cloud.ibm.com:30426
+users:
+- name: admin
+  user:
+    client-certificate: admin.pem
+    client-key: admin-key.pem
+- name: other
+  user:
+    client-certificate: unknown.pem
+`)
+	files := map[string][]byte{
+		"ca-fra02-mycluster.pem": []byte("ca"),
+		"admin.pem":              []byte("cert"),
+		"admin-key.pem":          []byte("key"),
+	}
+	embedded, err := embedClusterConfigFiles(kubeconfig, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config struct {
+		Clusters []struct {
+			Cluster map[string]string `json:"cluster"`
+		} `json:"clusters"`
+		Users []struct {
+			User map[string]string `json:"user"`
+		} `json:"users"`
+	}
+	if err := yaml.Unmarshal(embedded, &config); err != nil {
+		t.Fatal(err)
+	}
+	expectedCluster := map[string]string{
+		"certificate-authority-data": base64.StdEncoding.EncodeToString([]byte("ca")),
+		"server":                     "https://c1.eu-de.containers.cloud.ibm.com:30426",
+	}
+	if !reflect.DeepEqual(config.Clusters[0].Cluster, expectedCluster) {
+		t.Errorf("Expected the cluster %v, got %v", expectedCluster, config.Clusters[0].Cluster)
+	}
+	expectedUser := map[string]string{
+		"client-certificate-data": base64.StdEncoding.EncodeToString([]byte("cert")),
+		"client-key-data":         base64.StdEncoding.EncodeToString([]byte("key")),
+	}
+	if !reflect.DeepEqual(config.Users[0].User, expectedUser) {
+		t.Errorf("Expected the user %v, got %v", expectedUser, config.Users[0].User)
+	}
+	// The files missing from the archive are kept as references
+	if config.Users[1].User["client-certificate"] != "unknown.pem" {
+		t.Errorf("Expected the unknown certificate to be kept, got %v", config.Users[1].User)
+	}
+
+	if _, err := embedClusterConfigFiles([]byte("clusters: ["), files); err == nil {
+		t.Error("Expected an invalid kubeconfig to fail")
+	}
+}
+
+func TestClusterConfigExpiry(t *testing.T) {
+	certExpiry := time.Date(2027, 1, 2, 3, 4, 5, 0, time.UTC)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    certExpiry.AddDate(0, -1, 0),
+		NotAfter:     certExpiry,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin","exp":1798772645}`))
+	token := "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"
+
+	cases := []struct {
+		name     string
+		details  v1.ClusterKeyInfo
+		expected time.Time
+		ok       bool
+	}{
+		{"certificate first", v1.ClusterKeyInfo{Admin: cert, Token: token}, certExpiry, true},
+		{"token", v1.ClusterKeyInfo{Token: token}, time.Unix(1798772645, 0), true},
+		{"padded token", v1.ClusterKeyInfo{Token: "header." + base64.URLEncoding.EncodeToString([]byte(`{"exp":1798772645}`)) + ".signature"}, time.Unix(1798772645, 0), true},
+		{"token without exp", v1.ClusterKeyInfo{Token: "header." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin"}`)) + ".signature"}, time.Time{}, false},
+		{"invalid certificate and opaque token", v1.ClusterKeyInfo{Admin: "not a certificate", Token: "opaque"}, time.Time{}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expiry, ok := clusterConfigExpiry(c.details)
+			if ok != c.ok || !expiry.Equal(c.expected) {
+				t.Errorf("Expected the expiry %s (%t), got %s (%t)", c.expected, c.ok, expiry, ok)
+			}
+		})
+	}
+}
+
+func TestSetClusterConfigAccessExec(t *testing.T) {
+	details := v1.ClusterKeyInfo{Host: "https://c1.eu-de.containers.cloud.ibm.com:30426", ClusterCACertificate: "ca"}
+	execUser := func(t *testing.T, exec []interface{}) map[string]interface{} {
+		d := schema.TestResourceDataRaw(t, DataSourceIBMContainerClusterConfig().Schema, map[string]interface{}{
+			"cluster_name_id": "mycluster",
+			"exec":            exec,
+		})
+		if err := setClusterConfigAccess(d, "mycluster", details); err != nil {
+			t.Fatal(err)
+		}
+		var config struct {
+			Users []struct {
+				User struct {
+					Exec map[string]interface{} `json:"exec"`
+				} `json:"user"`
+			} `json:"users"`
+		}
+		if err := yaml.Unmarshal([]byte(d.Get("exec_kubeconfig").(string)), &config); err != nil {
+			t.Fatal(err)
+		}
+		return config.Users[0].User.Exec
+	}
+
+	t.Run("default IAM command", func(t *testing.T) {
+		exec := execUser(t, []interface{}{map[string]interface{}{}})
+		if exec["command"] != "sh" || !strings.Contains(exec["args"].([]interface{})[1].(string), "ibmcloud iam oauth-tokens") {
+			t.Errorf("Expected the IAM command, got %v", exec)
+		}
+	})
+
+	t.Run("custom command", func(t *testing.T) {
+		exec := execUser(t, []interface{}{map[string]interface{}{
+			"command": "/usr/local/bin/iks-exec-credential",
+			"args":    []interface{}{"--cluster", "mycluster"},
+			"env":     map[string]interface{}{"B": "2", "A": "1"},
+		}})
+		expected := map[string]interface{}{
+			"apiVersion": "client.authentication.k8s.io/v1beta1",
+			"command":    "/usr/local/bin/iks-exec-credential",
+			"args":       []interface{}{"--cluster", "mycluster"},
+			"env": []interface{}{
+				map[string]interface{}{"name": "A", "value": "1"},
+				map[string]interface{}{"name": "B", "value": "2"},
+			},
+		}
+		if !reflect.DeepEqual(exec, expected) {
+			t.Errorf("Expected the exec %v, got %v", expected, exec)
+		}
+	})
+
+	t.Run("args without command", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, DataSourceIBMContainerClusterConfig().Schema, map[string]interface{}{
+			"cluster_name_id": "mycluster",
+			"exec":            []interface{}{map[string]interface{}{"args": []interface{}{"--cluster"}}},
+		})
+		if err := setClusterConfigAccess(d, "mycluster", details); err == nil {
+			t.Error("Expected args without command to fail")
+		}
+	})
+}
@@ -62,6 +62,11 @@ func ResourceIBMContainerALBCert() *schema.Resource {
 				Optional:    true,
 				Description: "Persistence of secret",
 			},
+			"update_secret": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Increment the value to pull the latest version of the certificate into the secret",
+			},
 			"domain_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -121,19 +126,99 @@ func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{})
 		params.Persistence = v.(bool)
 	}
 
-	ingressAPI := ingressClient.Ingresses()
-	response, err := ingressAPI.CreateIngressSecret(params)
-
+	err = createContainerIngressSecret(d, ingressClient, params, meta)
 	if err != nil {
 		return err
 	}
-	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, response.Namespace))
+
+	return resourceIBMContainerALBCertRead(d, meta)
+}
+
+// containerIngressSecretIDParts returns the cluster, name and namespace of an
+// ingress secret ID. The IDs without a namespace are in ibm-cert-store.
+func containerIngressSecretIDParts(id string) (string, string, string, error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return "", "", "", err
+	}
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of cluster/secretName/secretNamespace", id)
+	}
+	namespace := "ibm-cert-store"
+	if len(parts) > 2 && len(parts[2]) > 0 {
+		namespace = parts[2]
+	}
+	return parts[0], parts[1], namespace, nil
+}
+
+// createContainerIngressSecret creates the TLS secret of a certificate, which
+// ibm_container_alb_cert and ibm_container_ingress_secret_tls manage.
+func createContainerIngressSecret(d *schema.ResourceData, ingressClient v2.ContainerServiceAPI, params v2.SecretCreateConfig, meta interface{}) error {
+	response, err := ingressClient.Ingresses().CreateIngressSecret(params)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating the ingress secret %s in namespace %s: %s", params.Name, params.Namespace, err)
+	}
+	namespace := params.Namespace
+	if response.Namespace != "" {
+		namespace = response.Namespace
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", params.Cluster, params.Name, namespace))
 	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource alb cert (%s) : %s", d.Id(), err)
+		return fmt.Errorf("[ERROR] Error waiting for create resource ingress secret (%s) : %s", d.Id(), err)
 	}
+	return nil
+}
 
-	return resourceIBMContainerALBCertRead(d, meta)
+// updateContainerIngressSecret updates the certificate of a TLS secret.
+// Updating the secret with the same CRN pulls the latest version of the
+// certificate, so it is also updated when update_secret changes.
+func updateContainerIngressSecret(d *schema.ResourceData, ingressClient v2.ContainerServiceAPI, meta interface{}) error {
+	if !d.HasChange("cert_crn") && !d.HasChange("update_secret") {
+		return nil
+	}
+	cluster, secretName, namespace, err := containerIngressSecretIDParts(d.Id())
+	if err != nil {
+		return err
+	}
+	params := v2.SecretUpdateConfig{
+		CRN:       d.Get("cert_crn").(string),
+		Cluster:   cluster,
+		Name:      secretName,
+		Namespace: namespace,
+	}
+	_, err = ingressClient.Ingresses().UpdateIngressSecret(params)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the ingress secret %s: %s", d.Id(), err)
+	}
+	_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for updating resource ingress secret (%s) : %s", d.Id(), err)
+	}
+	return nil
+}
+
+// deleteContainerIngressSecret deletes a TLS secret and waits until it is
+// gone, a secret which is already deleted is ignored.
+func deleteContainerIngressSecret(d *schema.ResourceData, ingressClient v2.ContainerServiceAPI, meta interface{}) error {
+	cluster, secretName, namespace, err := containerIngressSecretIDParts(d.Id())
+	if err != nil {
+		return err
+	}
+	params := v2.SecretDeleteConfig{
+		Cluster:   cluster,
+		Name:      secretName,
+		Namespace: namespace,
+	}
+	err = ingressClient.Ingresses().DeleteIngressSecret(params)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting the ingress secret %s: %s", d.Id(), err)
+	}
+	_, err = waitForALBCertDelete(d, meta, schema.TimeoutDelete)
+	return err
 }
 
 func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) error {
@@ -178,32 +263,10 @@ func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	ingressAPI := ingressClient.Ingresses()
-
-	parts, err := flex.IdParts(d.Id())
-	if err != nil {
-		return err
-	}
-	clusterID := parts[0]
-	secretName := parts[1]
-	namespace := "ibm-cert-store"
-	if len(parts) > 2 && len(parts[2]) > 0 {
-		namespace = parts[2]
-	}
-	params := v2.SecretDeleteConfig{
-		Cluster:   clusterID,
-		Name:      secretName,
-		Namespace: namespace,
-	}
-
-	err = ingressAPI.DeleteIngressSecret(params)
+	err = deleteContainerIngressSecret(d, ingressClient, meta)
 	if err != nil {
 		return err
 	}
-	_, albCertDeletionError := waitForALBCertDelete(d, meta, schema.TimeoutDelete)
-	if albCertDeletionError != nil {
-		return albCertDeletionError
-	}
 	d.SetId("")
 	return nil
 }
@@ -254,38 +317,10 @@ func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	parts, err := flex.IdParts(d.Id())
+	err = updateContainerIngressSecret(d, ingressClient, meta)
 	if err != nil {
 		return err
 	}
-	cluster := parts[0]
-	secretName := parts[1]
-	namespace := "ibm-cert-store"
-	if len(parts) > 2 && len(parts[2]) > 0 {
-		namespace = parts[2]
-	}
-
-	if d.HasChange("cert_crn") {
-		crn := d.Get("cert_crn").(string)
-		params := v2.SecretUpdateConfig{
-			CRN:       crn,
-			Cluster:   cluster,
-			Name:      secretName,
-			Namespace: namespace,
-		}
-		// params.State = "update_true"
-
-		ingressAPI := ingressClient.Ingresses()
-		_, err = ingressAPI.UpdateIngressSecret(params)
-		if err != nil {
-			return err
-		}
-
-		_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for updating resource alb cert (%s) : %s", d.Id(), err)
-		}
-	}
 	return resourceIBMContainerALBCertRead(d, meta)
 }
 
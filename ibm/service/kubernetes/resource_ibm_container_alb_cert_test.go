@@ -48,11 +48,22 @@ func TestAccIBMContainerALBCert_Basic(t *testing.T) {
 						"ibm_container_alb_cert.cert", "namespace", "ibm-cert-store"),
 				),
 			},
+			{
+				Config: testAccCheckIBMContainerALBCertUpdateSecret(clusterName, secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_alb_cert.cert", "cert_crn", acc.UpdatedCertCRN),
+					resource.TestCheckResourceAttr(
+						"ibm_container_alb_cert.cert", "update_secret", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_alb_cert.cert", "expires_on"),
+				),
+			},
 			{
 				ResourceName:            "ibm_container_alb_cert.cert",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "issuer_name"},
+				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret"},
 			},
 		},
 	})
@@ -131,3 +142,25 @@ resource "ibm_container_alb_cert" "cert" {
   namespace = "ibm-cert-store"
 }`, clusterName, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID, acc.UpdatedCertCRN, secretName)
 }
+
+func testAccCheckIBMContainerALBCertUpdateSecret(clusterName, secretName string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_cluster" "testacc_cluster" {
+  name              = "%s"
+  datacenter        = "%s"
+  default_pool_size = 1
+  machine_type      = "%s"
+  hardware          = "shared"
+  public_vlan_id    = "%s"
+  private_vlan_id   = "%s"
+  wait_till       = "MasterNodeReady"
+}
+
+resource "ibm_container_alb_cert" "cert" {
+  cert_crn      = "%s"
+  secret_name   = "%s"
+  cluster_id    = ibm_container_cluster.testacc_cluster.id
+  namespace     = "ibm-cert-store"
+  update_secret = 1
+}`, clusterName, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID, acc.UpdatedCertCRN, secretName)
+}
@@ -67,7 +67,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -436,6 +436,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the resource",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			"worker_pools": {
 				Type:     schema.TypeList,
@@ -891,6 +892,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1291,7 +1293,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange("tags_all") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
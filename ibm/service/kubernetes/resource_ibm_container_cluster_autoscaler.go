@@ -0,0 +1,570 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const (
+	clusterAutoscalerNamespace   = "kube-system"
+	clusterAutoscalerConfigMap   = "iks-ca-configmap"
+	clusterAutoscalerPoolsConfig = "workerPoolsConfig.json"
+)
+
+// clusterAutoscalerScaleDownSettings maps the attributes of the scale_down
+// block to the keys of the cluster-autoscaler ConfigMap.
+var clusterAutoscalerScaleDownSettings = map[string]string{
+	"delay_after_add":       "scaleDownDelayAfterAdd",
+	"delay_after_delete":    "scaleDownDelayAfterDelete",
+	"unneeded_time":         "scaleDownUnneededTime",
+	"unready_time":          "scaleDownUnreadyTime",
+	"utilization_threshold": "scaleDownUtilizationThreshold",
+}
+
+func ResourceIBMContainerClusterAutoscaler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerClusterAutoscalerCreate,
+		Read:   resourceIBMContainerClusterAutoscalerRead,
+		Update: resourceIBMContainerClusterAutoscalerUpdate,
+		Delete: resourceIBMContainerClusterAutoscalerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMContainerClusterAutoscalerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster Name or ID",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"worker_pool": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The worker pools scaled by the cluster autoscaler",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the worker pool",
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The minimum number of workers per zone of the worker pool",
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of workers per zone of the worker pool",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Set to false to stop scaling the worker pool",
+						},
+					},
+				},
+			},
+			"scale_down": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The scale-down settings of the cluster autoscaler. The settings which are not set keep their value in the cluster.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delay_after_add": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateClusterAutoscalerDuration,
+							Description:  "How long after a scale up the scale-down evaluation resumes, such as 10m",
+						},
+						"delay_after_delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateClusterAutoscalerDuration,
+							Description:  "How long after a worker is removed the scale-down evaluation resumes, such as 10s",
+						},
+						"unneeded_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateClusterAutoscalerDuration,
+							Description:  "How long a worker must be unneeded before it is removed, such as 10m",
+						},
+						"unready_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateClusterAutoscalerDuration,
+							Description:  "How long an unready worker must be unneeded before it is removed, such as 20m",
+						},
+						"utilization_threshold": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 1),
+							Description:  "The ratio of requested resources under which a worker is considered for removal",
+						},
+					},
+				},
+			},
+			"scan_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateClusterAutoscalerDuration,
+				Description:  "How often the cluster autoscaler evaluates the cluster, such as 1m",
+			},
+		},
+	}
+}
+
+func validateClusterAutoscalerDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 10m or 30s: %s", k, err))
+	}
+	return
+}
+
+// clusterAutoscalerWorkerPool is an entry of the workerPoolsConfig.json key of
+// the cluster-autoscaler ConfigMap.
+type clusterAutoscalerWorkerPool struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func resourceIBMContainerClusterAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	lockKey := "cluster_autoscaler_" + cluster
+	conns.IbmMutexKV.Lock(lockKey)
+	defer conns.IbmMutexKV.Unlock(lockKey)
+
+	kubeClient, err := clusterAutoscalerKubeClient(d, meta)
+	if err != nil {
+		return err
+	}
+	data, err := kubeClient.getConfigMapData(clusterAutoscalerNamespace, clusterAutoscalerConfigMap)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return fmt.Errorf("[ERROR] The cluster-autoscaler add-on is not installed on the cluster %s, install it with the ibm_container_addons resource", cluster)
+	}
+	if err := updateClusterAutoscalerConfigMap(d, kubeClient, data, nil); err != nil {
+		return err
+	}
+	d.SetId(cluster)
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+func resourceIBMContainerClusterAutoscalerRead(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Id()
+	d.Set("cluster", cluster)
+	kubeClient, err := clusterAutoscalerKubeClient(d, meta)
+	if err != nil {
+		return err
+	}
+	data, err := kubeClient.getConfigMapData(clusterAutoscalerNamespace, clusterAutoscalerConfigMap)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		log.Printf("[WARN] The cluster-autoscaler ConfigMap of the cluster %s is not found, removing the resource from the state", cluster)
+		d.SetId("")
+		return nil
+	}
+	pools, err := parseClusterAutoscalerWorkerPools(data)
+	if err != nil {
+		return err
+	}
+
+	// Only report the worker pools managed by this resource
+	managed := map[string]bool{}
+	for _, pool := range d.Get("worker_pool").(*schema.Set).List() {
+		managed[pool.(map[string]interface{})["name"].(string)] = true
+	}
+	workerPools := make([]clusterAutoscalerWorkerPool, 0, len(pools))
+	for _, pool := range pools {
+		if managed[pool.Name] {
+			workerPools = append(workerPools, pool)
+		}
+	}
+	if err := d.Set("worker_pool", flattenClusterAutoscalerWorkerPools(workerPools)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting worker_pool: %s", err)
+	}
+
+	scaleDown := map[string]interface{}{}
+	for attribute, key := range clusterAutoscalerScaleDownSettings {
+		value, ok := data[key]
+		if !ok {
+			continue
+		}
+		if attribute == "utilization_threshold" {
+			threshold, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error parsing %s %q of the cluster-autoscaler ConfigMap: %s", key, value, err)
+			}
+			scaleDown[attribute] = threshold
+		} else {
+			scaleDown[attribute] = value
+		}
+	}
+	if err := d.Set("scale_down", []map[string]interface{}{scaleDown}); err != nil {
+		return fmt.Errorf("[ERROR] Error setting scale_down: %s", err)
+	}
+	d.Set("scan_interval", data["scanInterval"])
+	return nil
+}
+
+// resourceIBMContainerClusterAutoscalerImport imports all the worker pools of
+// the cluster-autoscaler ConfigMap.
+func resourceIBMContainerClusterAutoscalerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("cluster", d.Id())
+	kubeClient, err := clusterAutoscalerKubeClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	data, err := kubeClient.getConfigMapData(clusterAutoscalerNamespace, clusterAutoscalerConfigMap)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("[ERROR] The cluster-autoscaler add-on is not installed on the cluster %s", d.Id())
+	}
+	pools, err := parseClusterAutoscalerWorkerPools(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("worker_pool", flattenClusterAutoscalerWorkerPools(pools)); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting worker_pool: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceIBMContainerClusterAutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("worker_pool") || d.HasChange("scale_down") || d.HasChange("scan_interval") {
+		cluster := d.Id()
+		lockKey := "cluster_autoscaler_" + cluster
+		conns.IbmMutexKV.Lock(lockKey)
+		defer conns.IbmMutexKV.Unlock(lockKey)
+
+		// The worker pools removed from the configuration are disabled
+		var removed []string
+		o, n := d.GetChange("worker_pool")
+		kept := map[string]bool{}
+		for _, pool := range n.(*schema.Set).List() {
+			kept[pool.(map[string]interface{})["name"].(string)] = true
+		}
+		for _, pool := range o.(*schema.Set).List() {
+			if name := pool.(map[string]interface{})["name"].(string); !kept[name] {
+				removed = append(removed, name)
+			}
+		}
+
+		kubeClient, err := clusterAutoscalerKubeClient(d, meta)
+		if err != nil {
+			return err
+		}
+		data, err := kubeClient.getConfigMapData(clusterAutoscalerNamespace, clusterAutoscalerConfigMap)
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			return fmt.Errorf("[ERROR] The cluster-autoscaler add-on is not installed on the cluster %s", cluster)
+		}
+		if err := updateClusterAutoscalerConfigMap(d, kubeClient, data, removed); err != nil {
+			return err
+		}
+	}
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+func resourceIBMContainerClusterAutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Id()
+	lockKey := "cluster_autoscaler_" + cluster
+	conns.IbmMutexKV.Lock(lockKey)
+	defer conns.IbmMutexKV.Unlock(lockKey)
+
+	kubeClient, err := clusterAutoscalerKubeClient(d, meta)
+	if err != nil {
+		return err
+	}
+	data, err := kubeClient.getConfigMapData(clusterAutoscalerNamespace, clusterAutoscalerConfigMap)
+	if err != nil {
+		return err
+	}
+	if data != nil {
+		// Disable the worker pools, the scale-down settings are left as they are
+		pools, err := parseClusterAutoscalerWorkerPools(data)
+		if err != nil {
+			return err
+		}
+		managed := map[string]bool{}
+		for _, pool := range d.Get("worker_pool").(*schema.Set).List() {
+			managed[pool.(map[string]interface{})["name"].(string)] = true
+		}
+		for i := range pools {
+			if managed[pools[i].Name] {
+				pools[i].Enabled = false
+			}
+		}
+		poolsConfig, err := json.Marshal(pools)
+		if err != nil {
+			return err
+		}
+		err = kubeClient.patchConfigMapData(clusterAutoscalerNamespace, clusterAutoscalerConfigMap, map[string]string{
+			clusterAutoscalerPoolsConfig: string(poolsConfig),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// updateClusterAutoscalerConfigMap writes the worker pools and the settings
+// of the configuration into the cluster-autoscaler ConfigMap, and disables
+// the removed worker pools. The other worker pools are left as they are.
+func updateClusterAutoscalerConfigMap(d *schema.ResourceData, kubeClient *kubeAPIClient, data map[string]string, removed []string) error {
+	pools, err := parseClusterAutoscalerWorkerPools(data)
+	if err != nil {
+		return err
+	}
+	index := make(map[string]int, len(pools))
+	for i, pool := range pools {
+		index[pool.Name] = i
+	}
+	for _, name := range removed {
+		if i, ok := index[name]; ok {
+			pools[i].Enabled = false
+		}
+	}
+	for _, poolIntf := range d.Get("worker_pool").(*schema.Set).List() {
+		p := poolIntf.(map[string]interface{})
+		pool := clusterAutoscalerWorkerPool{
+			Name:    p["name"].(string),
+			MinSize: p["min_size"].(int),
+			MaxSize: p["max_size"].(int),
+			Enabled: p["enabled"].(bool),
+		}
+		if pool.MinSize > pool.MaxSize {
+			return fmt.Errorf("[ERROR] The min_size %d of the worker pool %s is greater than its max_size %d", pool.MinSize, pool.Name, pool.MaxSize)
+		}
+		if i, ok := index[pool.Name]; ok {
+			pools[i] = pool
+		} else {
+			index[pool.Name] = len(pools)
+			pools = append(pools, pool)
+		}
+	}
+	poolsConfig, err := json.Marshal(pools)
+	if err != nil {
+		return err
+	}
+
+	patch := map[string]string{
+		clusterAutoscalerPoolsConfig: string(poolsConfig),
+	}
+	for attribute, key := range clusterAutoscalerScaleDownSettings {
+		switch value, _ := d.GetOk("scale_down.0." + attribute); value := value.(type) {
+		case string:
+			if value != "" {
+				patch[key] = value
+			}
+		case float64:
+			if value != 0 {
+				patch[key] = strconv.FormatFloat(value, 'f', -1, 64)
+			}
+		}
+	}
+	if scanInterval, ok := d.GetOk("scan_interval"); ok {
+		patch["scanInterval"] = scanInterval.(string)
+	}
+	return kubeClient.patchConfigMapData(clusterAutoscalerNamespace, clusterAutoscalerConfigMap, patch)
+}
+
+func flattenClusterAutoscalerWorkerPools(pools []clusterAutoscalerWorkerPool) []map[string]interface{} {
+	workerPools := make([]map[string]interface{}, 0, len(pools))
+	for _, pool := range pools {
+		workerPools = append(workerPools, map[string]interface{}{
+			"name":     pool.Name,
+			"min_size": pool.MinSize,
+			"max_size": pool.MaxSize,
+			"enabled":  pool.Enabled,
+		})
+	}
+	return workerPools
+}
+
+func parseClusterAutoscalerWorkerPools(data map[string]string) ([]clusterAutoscalerWorkerPool, error) {
+	pools := []clusterAutoscalerWorkerPool{}
+	if poolsConfig := strings.TrimSpace(data[clusterAutoscalerPoolsConfig]); poolsConfig != "" {
+		if err := json.Unmarshal([]byte(poolsConfig), &pools); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error parsing %s of the cluster-autoscaler ConfigMap: %s", clusterAutoscalerPoolsConfig, err)
+		}
+	}
+	return pools, nil
+}
+
+// clusterAutoscalerKubeClient downloads the admin cluster config in memory,
+// and returns a client of the Kubernetes API of the cluster using its
+// certificates.
+func clusterAutoscalerKubeClient(d *schema.ResourceData, meta interface{}) (*kubeAPIClient, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	_, clusterKeyDetails, err := getClusterConfigInMemory(csClient, d.Get("cluster").(string), true, targetEnv)
+	if err != nil {
+		return nil, err
+	}
+	return newKubeAPIClient(clusterKeyDetails)
+}
+
+// kubeAPIClient calls the Kubernetes API server of a cluster.
+type kubeAPIClient struct {
+	host   string
+	token  string
+	client *http.Client
+}
+
+func newKubeAPIClient(config v1.ClusterKeyInfo) (*kubeAPIClient, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("[ERROR] The cluster config has no Kubernetes API server")
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.ClusterCACertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.ClusterCACertificate)) {
+			return nil, fmt.Errorf("[ERROR] Error parsing the CA certificate of the cluster config")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.Admin != "" && config.AdminKey != "" {
+		cert, err := tls.X509KeyPair([]byte(config.Admin), []byte(config.AdminKey))
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error parsing the admin certificate of the cluster config: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return &kubeAPIClient{
+		host:  strings.TrimSuffix(config.Host, "/"),
+		token: config.Token,
+		client: &http.Client{
+			Timeout: 60 * time.Second,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+	}, nil
+}
+
+// do sends the request to the API server and decodes its response into
+// result. It returns the status code of the response.
+func (c *kubeAPIClient) do(method, path, contentType string, body, result interface{}) (int, error) {
+	var reqBody *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reqBody = bytes.NewReader(b)
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+	req, err := http.NewRequest(method, c.host+path, reqBody)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("[ERROR] Error calling the Kubernetes API %s %s: %s", method, path, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("[ERROR] Error calling the Kubernetes API %s %s: %s %s", method, path, resp.Status, respBody)
+	}
+	if result != nil {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return resp.StatusCode, fmt.Errorf("[ERROR] Error parsing the response of the Kubernetes API %s %s: %s", method, path, err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// getConfigMapData returns the data of the ConfigMap, or nil if it does not
+// exist.
+func (c *kubeAPIClient) getConfigMapData(namespace, name string) (map[string]string, error) {
+	var configMap struct {
+		Data map[string]string `json:"data"`
+	}
+	status, err := c.do(http.MethodGet, fmt.Sprintf("/api/v1/namespaces/%s/configmaps/%s", namespace, name), "", nil, &configMap)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	return configMap.Data, nil
+}
+
+// patchConfigMapData merges data into the data of the ConfigMap.
+func (c *kubeAPIClient) patchConfigMapData(namespace, name string, data map[string]string) error {
+	patch := map[string]interface{}{"data": data}
+	_, err := c.do(http.MethodPatch, fmt.Sprintf("/api/v1/namespaces/%s/configmaps/%s", namespace, name), "application/merge-patch+json", patch, nil)
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterAutoscaler_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-cluster-autoscaler-%d", acctest.RandIntRange(10, 100))
+	node := "ibm_container_cluster_autoscaler.autoscaler"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerConfig(name, 1, 2, "10m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "worker_pool.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(node, "worker_pool.*", map[string]string{
+						"name":     name,
+						"min_size": "1",
+						"max_size": "2",
+						"enabled":  "true",
+					}),
+					resource.TestCheckResourceAttr(node, "scale_down.0.unneeded_time", "10m"),
+					resource.TestCheckResourceAttr(node, "scale_down.0.utilization_threshold", "0.6"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerConfig(name, 1, 3, "20m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(node, "worker_pool.*", map[string]string{
+						"name":     name,
+						"max_size": "3",
+					}),
+					resource.TestCheckResourceAttr(node, "scale_down.0.unneeded_time", "20m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterAutoscalerConfig(name string, minSize, maxSize int, unneededTime string) string {
+	return fmt.Sprintf(`
+	provider "ibm"{
+		region = "eu-de"
+	}
+	resource "ibm_is_vpc" "vpc" {
+		name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet" {
+		name                     = "%[1]s"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "eu-de-1"
+		total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = ibm_is_vpc.vpc.id
+		flavor            = "cx2.2x4"
+		worker_count      = 1
+		wait_till         = "OneWorkerNodeReady"
+		zones {
+			subnet_id = ibm_is_subnet.subnet.id
+			name      = "eu-de-1"
+		}
+	}
+	resource "ibm_container_vpc_worker_pool" "pool" {
+		cluster            = ibm_container_vpc_cluster.cluster.id
+		worker_pool_name   = "%[1]s"
+		flavor             = "cx2.2x4"
+		vpc_id             = ibm_is_vpc.vpc.id
+		worker_count       = 1
+		autoscaler_managed = true
+		zones {
+			subnet_id = ibm_is_subnet.subnet.id
+			name      = "eu-de-1"
+		}
+	}
+	resource "ibm_container_addons" "addons" {
+		cluster = ibm_container_vpc_cluster.cluster.id
+		addons {
+			name    = "cluster-autoscaler"
+		}
+	}
+	resource "ibm_container_cluster_autoscaler" "autoscaler" {
+		cluster = ibm_container_addons.addons.cluster
+		worker_pool {
+			name     = ibm_container_vpc_worker_pool.pool.worker_pool_name
+			min_size = %[2]d
+			max_size = %[3]d
+		}
+		scale_down {
+			unneeded_time         = "%[4]s"
+			utilization_threshold = 0.6
+		}
+	}`, name, minSize, maxSize, unneededTime)
+}
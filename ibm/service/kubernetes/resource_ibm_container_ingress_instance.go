@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// ingressInstance is a Secrets Manager instance registered with a cluster
+type ingressInstance struct {
+	Cluster         string `json:"cluster"`
+	Name            string `json:"name"`
+	CRN             string `json:"crn"`
+	Type            string `json:"type"`
+	SecretGroupID   string `json:"secretGroupID"`
+	SecretGroupName string `json:"secretGroupName"`
+	IsDefault       bool   `json:"isDefault"`
+	UserManaged     bool   `json:"userManaged"`
+	Status          string `json:"status"`
+}
+
+// ingressInstanceRegisterConfig registers an instance with a cluster
+type ingressInstanceRegisterConfig struct {
+	Cluster       string `json:"cluster"`
+	CRN           string `json:"crn"`
+	IsDefault     bool   `json:"isDefault"`
+	SecretGroupID string `json:"secretGroupID,omitempty"`
+}
+
+// ingressInstanceUpdateConfig updates an instance registered with a cluster
+type ingressInstanceUpdateConfig struct {
+	Cluster       string `json:"cluster"`
+	Name          string `json:"name"`
+	IsDefault     bool   `json:"isDefault"`
+	SecretGroupID string `json:"secretGroupID"`
+}
+
+// ingressInstanceDeleteConfig unregisters an instance from a cluster
+type ingressInstanceDeleteConfig struct {
+	Cluster string `json:"cluster"`
+	Name    string `json:"name"`
+}
+
+func ResourceIBMContainerIngressInstance() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerIngressInstanceCreate,
+		Read:     resourceIBMContainerIngressInstanceRead,
+		Update:   resourceIBMContainerIngressInstanceUpdate,
+		Delete:   resourceIBMContainerIngressInstanceDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+			},
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CRN of the Secrets Manager instance to register",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Store the secrets of the IBM-provided Ingress subdomain certificates in this instance",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the secret group of the instance which stores the secrets. The secrets are not in a group by default",
+			},
+			"instance_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the instance in the cluster",
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the instance",
+			},
+			"secret_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the secret group",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the instance registration",
+			},
+			"user_managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the instance was registered by the user",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerIngressInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	params := ingressInstanceRegisterConfig{
+		Cluster:       cluster,
+		CRN:           d.Get("instance_crn").(string),
+		IsDefault:     d.Get("is_default").(bool),
+		SecretGroupID: d.Get("secret_group_id").(string),
+	}
+	var instance ingressInstance
+	_, err = rawClient.Post("/ingress/v2/secret/registerInstance", params, &instance)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error registering the instance %s with the cluster %s: %s", params.CRN, cluster, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", cluster, instance.Name))
+	_, err = waitForIngressInstance(d, rawClient, schema.TimeoutCreate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the registration of the ingress instance (%s): %s", d.Id(), err)
+	}
+
+	return resourceIBMContainerIngressInstanceRead(d, meta)
+}
+
+func getIngressInstance(rawClient containerRawClient, cluster, name string) (ingressInstance, error) {
+	var instance ingressInstance
+	_, err := rawClient.Get(fmt.Sprintf("/ingress/v2/secret/getInstance?cluster=%s&name=%s", url.QueryEscape(cluster), url.QueryEscape(name)), &instance)
+	return instance, err
+}
+
+func resourceIBMContainerIngressInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of cluster/instanceName", d.Id())
+	}
+	cluster := parts[0]
+	name := parts[1]
+
+	instance, err := getIngressInstance(rawClient, cluster, name)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Ingress instance %s is not found, removing it from the state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting ingress instance: %s", err)
+	}
+	d.Set("cluster", cluster)
+	d.Set("instance_crn", instance.CRN)
+	d.Set("is_default", instance.IsDefault)
+	d.Set("secret_group_id", instance.SecretGroupID)
+	d.Set("instance_name", instance.Name)
+	d.Set("instance_type", instance.Type)
+	d.Set("secret_group_name", instance.SecretGroupName)
+	d.Set("status", instance.Status)
+	d.Set("user_managed", instance.UserManaged)
+
+	return nil
+}
+
+func resourceIBMContainerIngressInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("is_default") || d.HasChange("secret_group_id") {
+		params := ingressInstanceUpdateConfig{
+			Cluster:       parts[0],
+			Name:          parts[1],
+			IsDefault:     d.Get("is_default").(bool),
+			SecretGroupID: d.Get("secret_group_id").(string),
+		}
+		_, err = rawClient.Post("/ingress/v2/secret/updateInstance", params, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the ingress instance %s: %s", d.Id(), err)
+		}
+		_, err = waitForIngressInstance(d, rawClient, schema.TimeoutUpdate)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the update of the ingress instance (%s): %s", d.Id(), err)
+		}
+	}
+	return resourceIBMContainerIngressInstanceRead(d, meta)
+}
+
+func resourceIBMContainerIngressInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	params := ingressInstanceDeleteConfig{
+		Cluster: parts[0],
+		Name:    parts[1],
+	}
+	_, err = rawClient.Post("/ingress/v2/secret/unregisterInstance", params, nil)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error unregistering the ingress instance %s: %s", d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func waitForIngressInstance(d *schema.ResourceData, rawClient containerRawClient, timeout string) (interface{}, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	cluster := parts[0]
+	name := parts[1]
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := getIngressInstance(rawClient, cluster, name)
+			if err != nil {
+				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+					return instance, "creating", nil
+				}
+				return nil, "", err
+			}
+			if strings.Contains(instance.Status, "failed") {
+				return instance, "failed", fmt.Errorf("[ERROR] The ingress instance %s failed: %s", d.Id(), instance.Status)
+			}
+			if instance.Status != "created" && instance.Status != "updated" {
+				return instance, "creating", nil
+			}
+			return instance, "done", nil
+		},
+		Timeout:    d.Timeout(timeout),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerIngressInstance_Basic(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-container-ingress-%d", acctest.RandIntRange(10, 100))
+	node := "ibm_container_ingress_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressInstanceConfig(clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "instance_crn", acc.SecretsManagerInstanceCRN),
+					resource.TestCheckResourceAttr(node, "is_default", "false"),
+					resource.TestCheckResourceAttrSet(node, "instance_name"),
+					resource.TestCheckResourceAttr(node, "user_managed", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressInstanceConfig(clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "is_default", "true"),
+				),
+			},
+			{
+				ResourceName:      node,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressInstanceDestroy(s *terraform.State) error {
+	csClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, ok := csClient.(interface {
+		Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	})
+	if !ok {
+		return fmt.Errorf("The container service client can't send raw requests")
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_container_ingress_instance" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = rawClient.Get(fmt.Sprintf("/ingress/v2/secret/getInstance?cluster=%s&name=%s", parts[0], parts[1]), nil)
+		if err == nil {
+			return fmt.Errorf("Ingress instance still exists: %s", rs.Primary.ID)
+		} else if !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("[ERROR] Error checking if ingress instance (%s) has been unregistered: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMContainerIngressInstanceConfig(clusterName string, isDefault bool) string {
+	return fmt.Sprintf(`
+resource "ibm_container_cluster" "testacc_cluster" {
+  name              = "%s"
+  datacenter        = "%s"
+  default_pool_size = 1
+  machine_type      = "%s"
+  hardware          = "shared"
+  public_vlan_id    = "%s"
+  private_vlan_id   = "%s"
+  wait_till         = "MasterNodeReady"
+}
+
+resource "ibm_container_ingress_instance" "instance" {
+  cluster      = ibm_container_cluster.testacc_cluster.id
+  instance_crn = "%s"
+  is_default   = %t
+}`, clusterName, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID, acc.SecretsManagerInstanceCRN, isDefault)
+}
@@ -0,0 +1,391 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+const ingressSecretTypeOpaque = "Opaque"
+
+// ingressSecretField is a field of an opaque secret, whose value is a secret
+// of Secrets Manager
+type ingressSecretField struct {
+	Name      string `json:"name"`
+	CRN       string `json:"crn"`
+	ExpiresOn string `json:"expiresOn,omitempty"`
+}
+
+// ingressSecret is an ingress secret with the fields of the opaque secrets
+type ingressSecret struct {
+	v2.Secret
+	Type   string               `json:"type"`
+	Fields []ingressSecretField `json:"fields"`
+}
+
+// ingressSecretFieldAdd adds a field to an opaque secret
+type ingressSecretFieldAdd struct {
+	Name         string `json:"name,omitempty"`
+	CRN          string `json:"crn"`
+	AppendPrefix bool   `json:"appendPrefix,omitempty"`
+}
+
+// ingressSecretFieldRemove removes a field of an opaque secret
+type ingressSecretFieldRemove struct {
+	Name string `json:"name"`
+}
+
+// ingressSecretOpaqueCreateConfig creates an opaque secret
+type ingressSecretOpaqueCreateConfig struct {
+	Cluster     string                  `json:"cluster"`
+	Name        string                  `json:"name"`
+	Namespace   string                  `json:"namespace"`
+	Persistence bool                    `json:"persistence"`
+	Type        string                  `json:"type"`
+	Add         []ingressSecretFieldAdd `json:"add"`
+}
+
+// ingressSecretFieldsConfig adds or removes the fields of an opaque secret
+type ingressSecretFieldsConfig struct {
+	Cluster   string                     `json:"cluster"`
+	Name      string                     `json:"name"`
+	Namespace string                     `json:"namespace"`
+	Add       []ingressSecretFieldAdd    `json:"add,omitempty"`
+	Remove    []ingressSecretFieldRemove `json:"remove,omitempty"`
+}
+
+func ResourceIBMContainerIngressSecretOpaque() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerIngressSecretOpaqueCreate,
+		Read:     resourceIBMContainerIngressSecretOpaqueRead,
+		Update:   resourceIBMContainerIngressSecretOpaqueUpdate,
+		Delete:   resourceIBMContainerIngressSecretOpaqueDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secret name",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace of the secret",
+			},
+			"persistence": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Persist the secret data in the cluster even if the user deletes the secret",
+			},
+			"fields": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         resourceIBMContainerIngressSecretFieldHash,
+				Description: "The fields of the secret, whose values are secrets of Secrets Manager",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "CRN of the secret in Secrets Manager",
+						},
+						"field_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the field. By default the name is derived from the name of the secret in Secrets Manager",
+						},
+						"prefix": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Prefix the default name of the field with the name of the secret in Secrets Manager",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the field in the secret",
+						},
+						"expires_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiration date of the secret in Secrets Manager",
+						},
+					},
+				},
+			},
+			"update_secret": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Increment the value to pull the latest version of the fields into the secret",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the secret",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret status",
+			},
+			"user_managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret was created by the user",
+			},
+		},
+	}
+}
+
+// resourceIBMContainerIngressSecretFieldHash hashes the configured arguments
+// of a field, the computed ones are set by the API.
+func resourceIBMContainerIngressSecretFieldHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["crn"].(string)))
+	if v, ok := m["field_name"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["prefix"]; ok && v.(bool) {
+		buf.WriteString("prefix-")
+	}
+	return conns.String(buf.String())
+}
+
+func expandIngressSecretFields(fields []interface{}) []ingressSecretFieldAdd {
+	add := make([]ingressSecretFieldAdd, 0, len(fields))
+	for _, f := range fields {
+		field := f.(map[string]interface{})
+		add = append(add, ingressSecretFieldAdd{
+			Name:         field["field_name"].(string),
+			CRN:          field["crn"].(string),
+			AppendPrefix: field["prefix"].(bool),
+		})
+	}
+	return add
+}
+
+func resourceIBMContainerIngressSecretOpaqueCreate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	secretName := d.Get("secret_name").(string)
+	namespace := d.Get("secret_namespace").(string)
+	params := ingressSecretOpaqueCreateConfig{
+		Cluster:     cluster,
+		Name:        secretName,
+		Namespace:   namespace,
+		Persistence: d.Get("persistence").(bool),
+		Type:        ingressSecretTypeOpaque,
+		Add:         expandIngressSecretFields(d.Get("fields").(*schema.Set).List()),
+	}
+	var response v2.Secret
+	_, err = rawClient.Post("/ingress/v2/secret/createSecret", params, &response)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating the ingress secret %s in namespace %s: %s", secretName, namespace, err)
+	}
+	if response.Namespace != "" {
+		namespace = response.Namespace
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, namespace))
+	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for create resource ingress secret (%s) : %s", d.Id(), err)
+	}
+
+	return resourceIBMContainerIngressSecretOpaqueRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretOpaqueRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 3 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of cluster/secretName/secretNamespace", d.Id())
+	}
+	cluster := parts[0]
+	secretName := parts[1]
+	namespace := parts[2]
+
+	var secret ingressSecret
+	_, err = rawClient.Get(fmt.Sprintf("/ingress/v2/secret/getSecret?cluster=%s&name=%s&namespace=%s", url.QueryEscape(cluster), url.QueryEscape(secretName), url.QueryEscape(namespace)), &secret)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Ingress secret %s is not found, removing it from the state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting ingress secret: %s", err)
+	}
+	d.Set("cluster", cluster)
+	d.Set("secret_name", secret.Name)
+	d.Set("secret_namespace", secret.Namespace)
+	d.Set("persistence", secret.Persistence)
+	d.Set("fields", flattenIngressSecretFields(secret.Fields, d.Get("fields").(*schema.Set).List()))
+	d.Set("type", secret.Type)
+	d.Set("status", secret.Status)
+	d.Set("user_managed", secret.UserManaged)
+
+	return nil
+}
+
+// flattenIngressSecretFields keeps the configured field_name and prefix of the
+// fields, which the API doesn't return. An imported field gets its name as
+// field_name.
+func flattenIngressSecretFields(fields []ingressSecretField, current []interface{}) []map[string]interface{} {
+	byCRN := map[string][]map[string]interface{}{}
+	for _, f := range current {
+		field := f.(map[string]interface{})
+		crn := field["crn"].(string)
+		byCRN[crn] = append(byCRN[crn], field)
+	}
+	result := make([]map[string]interface{}, 0, len(fields))
+	for _, field := range fields {
+		flattened := map[string]interface{}{
+			"crn":        field.CRN,
+			"field_name": field.Name,
+			"prefix":     false,
+			"name":       field.Name,
+			"expires_on": field.ExpiresOn,
+		}
+		if known := byCRN[field.CRN]; len(known) > 0 {
+			flattened["field_name"] = known[0]["field_name"]
+			flattened["prefix"] = known[0]["prefix"]
+			byCRN[field.CRN] = known[1:]
+		}
+		result = append(result, flattened)
+	}
+	return result
+}
+
+func resourceIBMContainerIngressSecretOpaqueUpdate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	rawClient, err := getContainerRawClient(csClient)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	secretName := parts[1]
+	namespace := parts[2]
+
+	if d.HasChange("fields") {
+		o, n := d.GetChange("fields")
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+		if len(removed) > 0 {
+			params := ingressSecretFieldsConfig{
+				Cluster:   cluster,
+				Name:      secretName,
+				Namespace: namespace,
+			}
+			for _, f := range removed {
+				params.Remove = append(params.Remove, ingressSecretFieldRemove{Name: f.(map[string]interface{})["name"].(string)})
+			}
+			_, err = rawClient.Post("/ingress/v2/secret/removeField", params, nil)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error removing the fields of the ingress secret %s: %s", d.Id(), err)
+			}
+			_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error waiting for updating resource ingress secret (%s) : %s", d.Id(), err)
+			}
+		}
+		if len(added) > 0 {
+			params := ingressSecretFieldsConfig{
+				Cluster:   cluster,
+				Name:      secretName,
+				Namespace: namespace,
+				Add:       expandIngressSecretFields(added),
+			}
+			_, err = rawClient.Post("/ingress/v2/secret/addField", params, nil)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error adding the fields of the ingress secret %s: %s", d.Id(), err)
+			}
+			_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error waiting for updating resource ingress secret (%s) : %s", d.Id(), err)
+			}
+		}
+	}
+
+	// Updating the secret pulls the latest version of its fields
+	if d.HasChange("update_secret") {
+		params := v2.SecretUpdateConfig{
+			Cluster:   cluster,
+			Name:      secretName,
+			Namespace: namespace,
+		}
+		_, err = csClient.Ingresses().UpdateIngressSecret(params)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the ingress secret %s: %s", d.Id(), err)
+		}
+		_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for updating resource ingress secret (%s) : %s", d.Id(), err)
+		}
+	}
+	return resourceIBMContainerIngressSecretOpaqueRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretOpaqueDelete(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	err = deleteContainerIngressSecret(d, csClient, meta)
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenIngressSecretFields(t *testing.T) {
+	fields := []ingressSecretField{
+		{Name: "username", CRN: "crn:secret:1", ExpiresOn: "2027-01-02"},
+		{Name: "db-password", CRN: "crn:secret:2"},
+		{Name: "imported", CRN: "crn:secret:3"},
+	}
+	// The configuration, before the names are known
+	current := []interface{}{
+		map[string]interface{}{"crn": "crn:secret:1", "field_name": "", "prefix": false, "name": "", "expires_on": ""},
+		map[string]interface{}{"crn": "crn:secret:2", "field_name": "", "prefix": true, "name": "", "expires_on": ""},
+	}
+	expected := []map[string]interface{}{
+		{"crn": "crn:secret:1", "field_name": "", "prefix": false, "name": "username", "expires_on": "2027-01-02"},
+		{"crn": "crn:secret:2", "field_name": "", "prefix": true, "name": "db-password", "expires_on": ""},
+		{"crn": "crn:secret:3", "field_name": "imported", "prefix": false, "name": "imported", "expires_on": ""},
+	}
+	flattened := flattenIngressSecretFields(fields, current)
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Errorf("Expected the fields %v, got %v", expected, flattened)
+	}
+
+	// The computed attributes don't change the hash, so the fields read back
+	// match the configuration
+	r := ResourceIBMContainerIngressSecretOpaque().Schema["fields"]
+	for i := range current {
+		if r.Set(current[i]) != r.Set(flattened[i]) {
+			t.Errorf("Expected the field %v to hash like %v", flattened[i], current[i])
+		}
+	}
+	if r.Set(flattened[2]) == r.Set(map[string]interface{}{"crn": "crn:secret:3", "field_name": "", "prefix": false}) {
+		t.Error("Expected field_name to change the hash")
+	}
+	set := schema.NewSet(r.Set, []interface{}{current[0], current[1]})
+	if set.Len() != 2 {
+		t.Errorf("Expected 2 fields, got %d", set.Len())
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerIngressSecretOpaque_Basic(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-container-ingress-%d", acctest.RandIntRange(10, 100))
+	secretName := fmt.Sprintf("tf-container-ingress-%d", acctest.RandIntRange(10, 100))
+	node := "ibm_container_ingress_secret_opaque.secret"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressSecretOpaqueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressSecretOpaqueConfig(clusterName, secretName, `
+  fields {
+    crn = "`+acc.SecretsManagerSecretCRN+`"
+  }`, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "secret_name", secretName),
+					resource.TestCheckResourceAttr(node, "secret_namespace", "default"),
+					resource.TestCheckResourceAttr(node, "type", "Opaque"),
+					resource.TestCheckResourceAttr(node, "fields.#", "1"),
+				),
+			},
+			{
+				// A field is added, and the secret is updated with the latest
+				// version of the fields
+				Config: testAccCheckIBMContainerIngressSecretOpaqueConfig(clusterName, secretName, `
+  fields {
+    crn = "`+acc.SecretsManagerSecretCRN+`"
+  }
+  fields {
+    crn        = "`+acc.UpdatedSecretsManagerSecretCRN+`"
+    field_name = "tf-field"
+  }`, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "fields.#", "2"),
+					resource.TestCheckResourceAttr(node, "update_secret", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressSecretOpaqueConfig(clusterName, secretName, `
+  fields {
+    crn        = "`+acc.UpdatedSecretsManagerSecretCRN+`"
+    field_name = "tf-field"
+  }`, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "fields.#", "1"),
+				),
+			},
+			{
+				ResourceName:            node,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressSecretOpaqueDestroy(s *terraform.State) error {
+	ingressClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_container_ingress_secret_opaque" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := ingressClient.Ingresses().GetIngressSecret(parts[0], parts[1], parts[2])
+		if err == nil && resp.Status == "deleted" {
+			return nil
+		} else if err == nil || !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("[ERROR] Error checking if ingress secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMContainerIngressSecretOpaqueConfig(clusterName, secretName, fields string, updateSecret int) string {
+	return fmt.Sprintf(`
+resource "ibm_container_cluster" "testacc_cluster" {
+  name              = "%s"
+  datacenter        = "%s"
+  default_pool_size = 1
+  machine_type      = "%s"
+  hardware          = "shared"
+  public_vlan_id    = "%s"
+  private_vlan_id   = "%s"
+  wait_till         = "MasterNodeReady"
+}
+
+resource "ibm_container_ingress_instance" "instance" {
+  cluster      = ibm_container_cluster.testacc_cluster.id
+  instance_crn = "%s"
+}
+
+resource "ibm_container_ingress_secret_opaque" "secret" {
+  cluster          = ibm_container_ingress_instance.instance.cluster
+  secret_name      = "%s"
+  secret_namespace = "default"
+  update_secret    = %d
+  %s
+}`, clusterName, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID, acc.SecretsManagerInstanceCRN, secretName, updateSecret, fields)
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// ResourceIBMContainerIngressSecretTLS manages the same TLS secrets as
+// ibm_container_alb_cert, in any namespace of the cluster.
+func ResourceIBMContainerIngressSecretTLS() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerIngressSecretTLSCreate,
+		Read:     resourceIBMContainerIngressSecretTLSRead,
+		Update:   resourceIBMContainerIngressSecretTLSUpdate,
+		Delete:   resourceIBMContainerIngressSecretTLSDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secret name",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace of the secret. Create one resource per namespace to copy the certificate into several namespaces",
+			},
+			"cert_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CRN of the certificate in Secrets Manager or Certificate Manager",
+			},
+			"persistence": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Persist the secret data in the cluster even if the user deletes the secret",
+			},
+			"update_secret": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Increment the value to pull the latest version of the certificate into the secret",
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Domain name of the certificate",
+			},
+			"expires_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the certificate",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret status",
+			},
+			"user_managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret was created by the user",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerIngressSecretTLSCreate(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	params := v2.SecretCreateConfig{
+		CRN:         d.Get("cert_crn").(string),
+		Cluster:     d.Get("cluster").(string),
+		Name:        d.Get("secret_name").(string),
+		Namespace:   d.Get("secret_namespace").(string),
+		Persistence: d.Get("persistence").(bool),
+	}
+	err = createContainerIngressSecret(d, ingressClient, params, meta)
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMContainerIngressSecretTLSRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretTLSRead(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	cluster, secretName, namespace, err := containerIngressSecretIDParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ingressSecretConfig, err := ingressClient.Ingresses().GetIngressSecret(cluster, secretName, namespace)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Ingress secret %s is not found, removing it from the state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting ingress secret: %s", err)
+	}
+	d.Set("cluster", cluster)
+	d.Set("secret_name", ingressSecretConfig.Name)
+	d.Set("secret_namespace", ingressSecretConfig.Namespace)
+	d.Set("cert_crn", ingressSecretConfig.CRN)
+	d.Set("persistence", ingressSecretConfig.Persistence)
+	d.Set("domain_name", ingressSecretConfig.Domain)
+	d.Set("expires_on", ingressSecretConfig.ExpiresOn)
+	d.Set("status", ingressSecretConfig.Status)
+	d.Set("user_managed", ingressSecretConfig.UserManaged)
+
+	return nil
+}
+
+func resourceIBMContainerIngressSecretTLSUpdate(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	err = updateContainerIngressSecret(d, ingressClient, meta)
+	if err != nil {
+		return err
+	}
+	return resourceIBMContainerIngressSecretTLSRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretTLSDelete(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	err = deleteContainerIngressSecret(d, ingressClient, meta)
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerIngressSecretTLS_Basic(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-container-ingress-%d", acctest.RandIntRange(10, 100))
+	secretName := fmt.Sprintf("tf-container-ingress-%d", acctest.RandIntRange(10, 100))
+	node := "ibm_container_ingress_secret_tls.secret"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressSecretTLSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressSecretTLSConfig(clusterName, secretName, acc.CertCRN, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "secret_name", secretName),
+					resource.TestCheckResourceAttr(node, "secret_namespace", "default"),
+					resource.TestCheckResourceAttr(node, "cert_crn", acc.CertCRN),
+					resource.TestCheckResourceAttrSet(node, "domain_name"),
+					resource.TestCheckResourceAttrSet(node, "expires_on"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_tls.copy", "secret_namespace", "kube-system"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressSecretTLSConfig(clusterName, secretName, acc.UpdatedCertCRN, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "cert_crn", acc.UpdatedCertCRN),
+					resource.TestCheckResourceAttr(node, "update_secret", "2"),
+				),
+			},
+			{
+				ResourceName:            node,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressSecretTLSDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_container_ingress_secret_tls" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		ingressClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+
+		resp, err := ingressClient.Ingresses().GetIngressSecret(parts[0], parts[1], parts[2])
+		if err == nil && resp.Status == "deleted" {
+			return nil
+		} else if err == nil || !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("[ERROR] Error checking if ingress secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMContainerIngressSecretTLSConfig(clusterName, secretName, certCRN string, updateSecret int) string {
+	return fmt.Sprintf(`
+resource "ibm_container_cluster" "testacc_cluster" {
+  name              = "%[1]s"
+  datacenter        = "%[2]s"
+  default_pool_size = 1
+  machine_type      = "%[3]s"
+  hardware          = "shared"
+  public_vlan_id    = "%[4]s"
+  private_vlan_id   = "%[5]s"
+  wait_till         = "MasterNodeReady"
+}
+
+resource "ibm_container_ingress_secret_tls" "secret" {
+  cluster          = ibm_container_cluster.testacc_cluster.id
+  secret_name      = "%[6]s"
+  secret_namespace = "default"
+  cert_crn         = "%[7]s"
+  update_secret    = %[8]d
+}
+
+resource "ibm_container_ingress_secret_tls" "copy" {
+  cluster          = ibm_container_cluster.testacc_cluster.id
+  secret_name      = "%[6]s"
+  secret_namespace = "kube-system"
+  cert_crn         = ibm_container_ingress_secret_tls.secret.cert_crn
+}`, clusterName, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID, secretName, certCRN, updateSecret)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -46,8 +47,17 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			customdiff.IfValue("wait_for_worker_update", func(_ context.Context, value, meta interface{}) bool {
+				return !value.(bool)
+			}, func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				// The workers are all replaced at once without waiting for them
+				if l, ok := diff.GetOk("update_strategy"); ok && len(l.([]interface{})) > 0 {
+					return fmt.Errorf("[ERROR] update_strategy requires wait_for_worker_update to be true")
+				}
+				return nil
+			}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +177,8 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"update_strategy": vpcWorkerUpdateStrategySchema(true),
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -240,6 +252,7 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			"wait_till": {
 				Type:             schema.TypeString,
@@ -566,7 +579,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 	clusterID := d.Id()
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange("tags_all") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
@@ -657,73 +670,31 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		}
 
 		clusterID := d.Id()
-		cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
-		}
 
 		// Update the worker nodes after master node kube-version is updated.
-		// workers will store the existing workers info to identify the replaced node
-		workersInfo := make(map[string]int)
-
 		updateAllWorkers := d.Get("update_all_workers").(bool)
 		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
-
-			// patchVersion := d.Get("patch_version").(string)
-			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
-			if err != nil {
-				d.Set("patch_version", nil)
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
-			}
-
-			for index, worker := range workers {
-				workersInfo[worker.ID] = index
-			}
-			workersCount := len(workers)
-
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
-
-			for _, worker := range workers {
-				// check if change is present in MAJOR.MINOR version or in PATCH version
-				if worker.KubeVersion.Actual != worker.KubeVersion.Target {
-					_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
-					// As API returns http response 204 NO CONTENT, error raised will be exempted.
-					if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
-						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
-					}
-
-					if waitForWorkerUpdate {
-						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
-						if deleteError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
-						}
-
-						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
-						if newWorkerError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Failed to spawn new worker node")
-						}
-
-						//3. Get new worker node ID and update the map
-						newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
-						if newNodeError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Unable to find the new worker node info")
-						}
-
-						delete(workersInfo, worker.ID)
-						workersInfo[newWorkerID] = index
-
-						//4. wait for the worker's version update and normal state
-						_, Err := WaitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
-						if Err != nil {
+			if waitForWorkerUpdate {
+				err = expandVpcWorkerUpdateStrategy(d).updateWorkers(csClient.Workers(), clusterID, "", targetEnv, d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					d.Set("patch_version", nil)
+					return err
+				}
+			} else {
+				workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+				if err != nil {
+					d.Set("patch_version", nil)
+					return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+				}
+				for _, worker := range workers {
+					// check if change is present in MAJOR.MINOR version or in PATCH version
+					if worker.KubeVersion.Actual != worker.KubeVersion.Target {
+						_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+						// As API returns http response 204 NO CONTENT, error raised will be exempted.
+						if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
 							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
+							return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
 						}
 					}
 				}
@@ -978,6 +949,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1301,55 +1273,212 @@ func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *sche
 	}
 }
 
-// WaitForVpcClusterWokersVersionUpdate Waits for Cluster version Update
-func WaitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion, workerID string) (interface{}, error) {
-	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
-	if err != nil {
-		return nil, err
+// vpcWorkerUpdateStrategySchema is the update_strategy block of the VPC
+// clusters and worker pools. Only the clusters order their worker pools.
+func vpcWorkerUpdateStrategySchema(withPoolOrder bool) *schema.Schema {
+	strategy := map[string]*schema.Schema{
+		"max_unavailable": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The number of workers of a worker pool which are replaced at the same time. The workers of the pool which are not normal count as unavailable.",
+		},
+		"pause_on_failure": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Stop the update at the first worker which fails to be replaced. If false, the other worker pools are still updated and the failures are reported at the end.",
+		},
+		"batch_delay": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of seconds to wait before each batch of workers is replaced. It is only a delay, the workers are not cordoned nor drained before their replacement.",
+		},
 	}
+	if withPoolOrder {
+		strategy["pool_order"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The names or IDs of the worker pools to update first, in order. The other worker pools are updated after them, by name.",
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "How the workers are replaced when their kube version is updated",
+		Elem:        &schema.Resource{Schema: strategy},
+	}
+}
 
-	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
-	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{"retry", versionUpdating},
-		Target:                    []string{workerNormal},
-		Refresh:                   vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerID, clusterID, d, target, masterVersion),
-		Timeout:                   d.Timeout(schema.TimeoutUpdate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                10 * time.Second,
-		ContinuousTargetOccurence: 5,
+// vpcWorkerUpdateStrategy replaces the workers of a VPC cluster whose kube
+// version is behind their target one pool at a time, in batches of at most
+// maxUnavailable workers of the pool.
+type vpcWorkerUpdateStrategy struct {
+	maxUnavailable int
+	poolOrder      []string
+	pauseOnFailure bool
+	batchDelay     time.Duration
+}
+
+func expandVpcWorkerUpdateStrategy(d *schema.ResourceData) vpcWorkerUpdateStrategy {
+	strategy := vpcWorkerUpdateStrategy{maxUnavailable: 1, pauseOnFailure: true}
+	if l, ok := d.GetOk("update_strategy"); ok && len(l.([]interface{})) > 0 && l.([]interface{})[0] != nil {
+		m := l.([]interface{})[0].(map[string]interface{})
+		strategy.maxUnavailable = m["max_unavailable"].(int)
+		strategy.pauseOnFailure = m["pause_on_failure"].(bool)
+		strategy.batchDelay = time.Duration(m["batch_delay"].(int)) * time.Second
+		if order, ok := m["pool_order"]; ok {
+			strategy.poolOrder = flex.ExpandStringList(order.([]interface{}))
+		}
 	}
+	return strategy
+}
 
-	return stateConf.WaitForState()
+// orderPools returns the pools of the workers in the pool order, followed by
+// the other pools by name.
+func (s vpcWorkerUpdateStrategy) orderPools(workers map[string][]v2.Worker) []string {
+	ordered := make([]string, 0, len(workers))
+	seen := map[string]bool{}
+	for _, nameOrID := range s.poolOrder {
+		for pool, poolWorkers := range workers {
+			if !seen[pool] && (pool == nameOrID || poolWorkers[0].PoolID == nameOrID) {
+				ordered = append(ordered, pool)
+				seen[pool] = true
+			}
+		}
+	}
+	rest := []string{}
+	for pool := range workers {
+		if !seen[pool] {
+			rest = append(rest, pool)
+		}
+	}
+	sort.Strings(rest)
+	return append(ordered, rest...)
 }
 
-func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		worker, err := client.Get(clusterID, workerID, target)
-		if err != nil {
-			return nil, "retry", fmt.Errorf("[ERROR] Error retrieving worker of container vpc cluster: %s", err)
+// updateWorkers replaces the outdated workers of the cluster, or only those of
+// the worker pool poolNameOrID if it is not empty.
+func (s vpcWorkerUpdateStrategy) updateWorkers(client v2.Workers, clusterID, poolNameOrID string, target v2.ClusterTargetHeader, timeout time.Duration) error {
+	workers, err := client.ListWorkers(clusterID, false, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	known := make(map[string]bool, len(workers))
+	outdated := map[string][]v2.Worker{}
+	for _, worker := range workers {
+		known[worker.ID] = true
+		if poolNameOrID != "" && worker.PoolName != poolNameOrID && worker.PoolID != poolNameOrID {
+			continue
 		}
-		// Check active updates
-		if worker.Health.State == "normal" {
-			return worker, workerNormal, nil
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target {
+			outdated[worker.PoolName] = append(outdated[worker.PoolName], worker)
 		}
-		return worker, versionUpdating, nil
 	}
+
+	failures := []string{}
+	for _, pool := range s.orderPools(outdated) {
+		poolWorkers := outdated[pool]
+		log.Printf("[INFO] Updating %d workers of worker pool %s, %d at a time", len(poolWorkers), pool, s.maxUnavailable)
+		for len(poolWorkers) > 0 {
+			err := s.updateBatch(client, clusterID, pool, &poolWorkers, known, len(workers), target, timeout)
+			if err != nil {
+				if s.pauseOnFailure {
+					return err
+				}
+				log.Printf("[WARN] Skipping the other workers of worker pool %s: %s", pool, err)
+				failures = append(failures, err.Error())
+				break
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("[ERROR] Error updating the workers of %d worker pools:\n%s", len(failures), strings.Join(failures, "\n"))
+	}
+	return nil
 }
 
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+// updateBatch replaces the next workers of the pool, as many as the workers of
+// the pool which are not normal allow, and waits for their replacements to be
+// normal.
+func (s vpcWorkerUpdateStrategy) updateBatch(client v2.Workers, clusterID, pool string, poolWorkers *[]v2.Worker, known map[string]bool, workersCount int, target v2.ClusterTargetHeader, timeout time.Duration) error {
+	workers, err := client.ListWorkers(clusterID, false, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	size := s.maxUnavailable
+	for _, worker := range workers {
+		if worker.PoolName == pool && worker.Health.State != workerNormal {
+			size--
+		}
+	}
+	if size <= 0 {
+		return fmt.Errorf("[ERROR] Worker pool %s has %d or more workers which are not normal, the update is paused", pool, s.maxUnavailable)
+	}
+	if size > len(*poolWorkers) {
+		size = len(*poolWorkers)
+	}
+	batch := (*poolWorkers)[:size]
+	*poolWorkers = (*poolWorkers)[size:]
 
-	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if s.batchDelay > 0 {
+		log.Printf("[INFO] Waiting %s before replacing %d workers of worker pool %s", s.batchDelay, len(batch), pool)
+		time.Sleep(s.batchDelay)
+	}
+	for _, worker := range batch {
+		_, err := client.ReplaceWokerNode(clusterID, worker.ID, target)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			return fmt.Errorf("[ERROR] Error replacing the worker node %s from the cluster: %s", worker.ID, err)
+		}
+	}
+
+	//1. wait for the worker nodes to delete
+	for _, worker := range batch {
+		_, err := waitForVpcWorkerDeleted(client, clusterID, worker.ID, target, timeout)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
+		}
+		delete(known, worker.ID)
+	}
+
+	//2. wait for the new worker nodes
+	_, err = waitForVpcWorkersCount(client, clusterID, workersCount, target, timeout)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("[ERROR] Failed to spawn new worker nodes in worker pool %s", pool)
 	}
 
-	clusterID := d.Id()
+	//3. wait for the new workers' version update and normal state
+	workers, err = client.ListWorkers(clusterID, false, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	for _, worker := range workers {
+		if known[worker.ID] {
+			continue
+		}
+		log.Println("found new replaced node: ", worker.ID)
+		known[worker.ID] = true
+		_, err := waitForVpcWorkerNormal(client, clusterID, worker.ID, target, timeout)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for cluster (%s) worker node %s kube version to be updated: %s", clusterID, worker.ID, err)
+		}
+	}
+	return nil
+}
+
+func waitForVpcWorkerDeleted(client v2.Workers, clusterID, workerID string, target v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
 	deleteStateConf := &resource.StateChangeConf{
 		Pending: []string{workerDeletePending},
 		Target:  []string{workerDeleteState},
 		Refresh: func() (interface{}, string, error) {
-			worker, err := csClient.Workers().Get(clusterID, workerID, targetEnv)
+			worker, err := client.Get(clusterID, workerID, target)
 			if err != nil {
 				return worker, workerDeletePending, nil
 			}
@@ -1358,7 +1487,7 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 			}
 			return worker, workerDeletePending, nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Timeout:      timeout,
 		Delay:        10 * time.Second,
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
@@ -1366,18 +1495,12 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 	return deleteStateConf.WaitForState()
 }
 
-func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
-	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
-	if err != nil {
-		return nil, err
-	}
-
-	clusterID := d.Id()
+func waitForVpcWorkersCount(client v2.Workers, clusterID string, workersCount int, target v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"creating"},
 		Target:  []string{"created"},
 		Refresh: func() (interface{}, string, error) {
-			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+			workers, err := client.ListWorkers(clusterID, false, target)
 			if err != nil {
 				return workers, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
 			}
@@ -1386,7 +1509,7 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 			}
 			return workers, "creating", nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Timeout:      timeout,
 		Delay:        10 * time.Second,
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
@@ -1394,24 +1517,25 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 	return stateConf.WaitForState()
 }
 
-func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
-	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
-	if err != nil {
-		return "", -1, err
-	}
-
-	clusterID := d.Id()
-
-	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
-	if err != nil {
-		return "", -1, fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
-	}
-
-	for index, worker := range workers {
-		if _, ok := workersInfo[worker.ID]; !ok {
-			log.Println("found new replaced node: ", worker.ID)
-			return worker.ID, index, nil
-		}
+func waitForVpcWorkerNormal(client v2.Workers, clusterID, workerID string, target v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", versionUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := client.Get(clusterID, workerID, target)
+			if err != nil {
+				return nil, "retry", fmt.Errorf("[ERROR] Error retrieving worker of container vpc cluster: %s", err)
+			}
+			if worker.Health.State == "normal" {
+				return worker, workerNormal, nil
+			}
+			return worker, versionUpdating, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 5,
 	}
-	return "", -1, fmt.Errorf("[ERROR] no new node found")
+	return stateConf.WaitForState()
 }
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeWorkers serves the workers of a cluster, the methods the update
+// strategy doesn't call panic.
+type fakeWorkers struct {
+	v2.Workers
+	workers  []v2.Worker
+	replaced []string
+	// Replacing these workers fails
+	failing map[string]bool
+}
+
+func (f *fakeWorkers) ListWorkers(clusterIDOrName string, showDeleted bool, target v2.ClusterTargetHeader) ([]v2.Worker, error) {
+	return f.workers, nil
+}
+
+func (f *fakeWorkers) ReplaceWokerNode(clusterIDOrName, workerID string, target v2.ClusterTargetHeader) (string, error) {
+	f.replaced = append(f.replaced, workerID)
+	if f.failing[workerID] {
+		return "", errors.New("Request failed with status code: 409")
+	}
+	return "", nil
+}
+
+func fakeWorker(id, pool, health string, outdated bool) v2.Worker {
+	worker := v2.Worker{ID: id, PoolID: pool + "-id", PoolName: pool}
+	worker.Health.State = health
+	worker.KubeVersion.Actual = "1.22.9_1543"
+	worker.KubeVersion.Target = "1.22.9_1543"
+	if outdated {
+		worker.KubeVersion.Target = "1.22.10_1545"
+	}
+	return worker
+}
+
+func TestVpcWorkerUpdateStrategyOrderPools(t *testing.T) {
+	workers := map[string][]v2.Worker{
+		"default": {fakeWorker("w1", "default", workerNormal, true)},
+		"gpu":     {fakeWorker("w2", "gpu", workerNormal, true)},
+		"edge":    {fakeWorker("w3", "edge", workerNormal, true)},
+		"batch":   {fakeWorker("w4", "batch", workerNormal, true)},
+	}
+	cases := []struct {
+		name      string
+		poolOrder []string
+		expected  []string
+	}{
+		{"no order", nil, []string{"batch", "default", "edge", "gpu"}},
+		{"names", []string{"gpu", "default"}, []string{"gpu", "default", "batch", "edge"}},
+		{"ID", []string{"edge-id"}, []string{"edge", "batch", "default", "gpu"}},
+		{"duplicates and unknown pools", []string{"gpu", "unknown", "gpu-id"}, []string{"gpu", "batch", "default", "edge"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := vpcWorkerUpdateStrategy{maxUnavailable: 1, poolOrder: c.poolOrder}
+			if pools := s.orderPools(workers); !reflect.DeepEqual(pools, c.expected) {
+				t.Errorf("Expected the pools %v, got %v", c.expected, pools)
+			}
+		})
+	}
+}
+
+func TestVpcWorkerUpdateStrategyUpdateBatch(t *testing.T) {
+	t.Run("unavailable workers shrink the batch", func(t *testing.T) {
+		client := &fakeWorkers{
+			workers: []v2.Worker{
+				fakeWorker("w1", "default", workerNormal, true),
+				fakeWorker("w2", "default", workerNormal, true),
+				fakeWorker("w3", "default", workerNormal, true),
+				fakeWorker("w4", "default", workerNormal, true),
+				fakeWorker("w5", "default", "warning", false),
+				// The workers of the other pools don't count
+				fakeWorker("w6", "edge", "critical", false),
+			},
+			// The batch stops at the first failure, before waiting
+			failing: map[string]bool{"w2": true},
+		}
+		pool := client.workers[:4]
+		s := vpcWorkerUpdateStrategy{maxUnavailable: 3}
+		err := s.updateBatch(client, "cluster", "default", &pool, map[string]bool{}, len(client.workers), v2.ClusterTargetHeader{}, 0)
+		if err == nil || !strings.Contains(err.Error(), "w2") {
+			t.Errorf("Expected the replace of w2 to fail, got %v", err)
+		}
+		if !reflect.DeepEqual(client.replaced, []string{"w1", "w2"}) {
+			t.Errorf("Expected a batch of w1 and w2, got %v", client.replaced)
+		}
+		if len(pool) != 2 || pool[0].ID != "w3" {
+			t.Errorf("Expected w3 and w4 to be left, got %v", pool)
+		}
+	})
+
+	t.Run("paused while too many workers are unavailable", func(t *testing.T) {
+		client := &fakeWorkers{
+			workers: []v2.Worker{
+				fakeWorker("w1", "default", workerNormal, true),
+				fakeWorker("w2", "default", "critical", false),
+				fakeWorker("w3", "default", "warning", false),
+			},
+		}
+		pool := client.workers[:1]
+		s := vpcWorkerUpdateStrategy{maxUnavailable: 2}
+		err := s.updateBatch(client, "cluster", "default", &pool, map[string]bool{}, len(client.workers), v2.ClusterTargetHeader{}, 0)
+		if err == nil || !strings.Contains(err.Error(), "paused") {
+			t.Errorf("Expected the update to pause, got %v", err)
+		}
+		if len(client.replaced) != 0 || len(pool) != 1 {
+			t.Errorf("Expected no worker to be replaced, got %v", client.replaced)
+		}
+	})
+}
+
+func TestVpcWorkerUpdateStrategyUpdateWorkers(t *testing.T) {
+	newClient := func() *fakeWorkers {
+		return &fakeWorkers{
+			workers: []v2.Worker{
+				fakeWorker("w1", "default", workerNormal, true),
+				fakeWorker("w2", "edge", workerNormal, true),
+				fakeWorker("w3", "edge", workerNormal, false),
+			},
+			failing: map[string]bool{"w1": true, "w2": true},
+		}
+	}
+
+	client := newClient()
+	s := vpcWorkerUpdateStrategy{maxUnavailable: 1, poolOrder: []string{"edge"}, pauseOnFailure: true}
+	if err := s.updateWorkers(client, "cluster", "", v2.ClusterTargetHeader{}, 0); err == nil {
+		t.Error("Expected the update to fail")
+	}
+	if !reflect.DeepEqual(client.replaced, []string{"w2"}) {
+		t.Errorf("Expected the update to stop at the first pool, got %v", client.replaced)
+	}
+
+	client = newClient()
+	s.pauseOnFailure = false
+	err := s.updateWorkers(client, "cluster", "", v2.ClusterTargetHeader{}, 0)
+	if err == nil || !strings.Contains(err.Error(), "2 worker pools") {
+		t.Errorf("Expected the failures of both pools, got %v", err)
+	}
+	if !reflect.DeepEqual(client.replaced, []string{"w2", "w1"}) {
+		t.Errorf("Expected the outdated workers of both pools to be replaced, got %v", client.replaced)
+	}
+
+	client = newClient()
+	if err := s.updateWorkers(client, "cluster", "default-id", v2.ClusterTargetHeader{}, 0); err == nil {
+		t.Error("Expected the update to fail")
+	}
+	if !reflect.DeepEqual(client.replaced, []string{"w1"}) {
+		t.Errorf("Expected only the workers of the pool to be replaced, got %v", client.replaced)
+	}
+}
+
+func TestVpcClusterUpdateStrategyNeedsWaitForWorkerUpdate(t *testing.T) {
+	r := ResourceIBMContainerVpcCluster()
+	config := map[string]interface{}{
+		"name":                   "cluster",
+		"vpc_id":                 "vpc",
+		"flavor":                 "bx2.4x16",
+		"zones":                  []interface{}{map[string]interface{}{"name": "us-south-1", "subnet_id": "subnet"}},
+		"wait_for_worker_update": false,
+		"update_strategy":        []interface{}{map[string]interface{}{"max_unavailable": 2}},
+	}
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	if err == nil || !strings.Contains(err.Error(), "wait_for_worker_update") {
+		t.Errorf("Expected update_strategy to be rejected, got %v", err)
+	}
+
+	config["wait_for_worker_update"] = true
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil); err != nil {
+		t.Errorf("Expected update_strategy to be accepted, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ package kubernetes_test
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -365,3 +366,119 @@ func testAccCheckIBMContainerVpcClusterImageSecuritySetting(name, setting string
 		image_security_enforcement = %s
 	  }`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.SubnetID, setting)
 }
+
+func TestAccIBMContainerVpcClusterUpdateStrategy(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMContainerVpcClusterUpdateStrategy(name, acc.KubeVersion, false, "", false),
+				ExpectError: regexp.MustCompile("update_strategy requires wait_for_worker_update"),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterUpdateStrategy(name, acc.KubeVersion, false, "", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_count", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "update_strategy.0.max_unavailable", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "update_strategy.0.batch_delay", "30"),
+				),
+			},
+			{
+				// The master is updated, then the workers one at a time
+				Config: testAccCheckIBMContainerVpcClusterUpdateStrategy(name, acc.KubeUpdateVersion, true, "", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "kube_version", acc.KubeUpdateVersion),
+					testAccCheckIBMContainerVpcWorkersUpdated("ibm_container_vpc_cluster.cluster", ""),
+				),
+			},
+			{
+				// A patch_version change rolls the workers to the latest patch
+				Config: testAccCheckIBMContainerVpcClusterUpdateStrategy(name, acc.KubeUpdateVersion, true, "patch-1", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "patch_version", "patch-1"),
+					testAccCheckIBMContainerVpcWorkersUpdated("ibm_container_vpc_cluster.cluster", ""),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMContainerVpcWorkersUpdated checks that the workers of the
+// cluster, or of its worker pool, run their target version.
+func testAccCheckIBMContainerVpcWorkersUpdated(n, pool string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		csClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+
+		workers, err := csClient.Workers().ListWorkers(rs.Primary.ID, false, getVpcClusterTargetHeaderTestACC())
+		if err != nil {
+			return err
+		}
+		for _, worker := range workers {
+			if pool != "" && worker.PoolName != pool {
+				continue
+			}
+			if worker.KubeVersion.Actual != worker.KubeVersion.Target {
+				return fmt.Errorf("Worker %s runs %s instead of %s", worker.ID, worker.KubeVersion.Actual, worker.KubeVersion.Target)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMContainerVpcClusterUpdateStrategy(name, kubeVersion string, updateAllWorkers bool, patchVersion string, waitForWorkerUpdate bool) string {
+	return fmt.Sprintf(`
+provider "ibm" {
+	region ="eu-de"
+}
+data "ibm_resource_group" "resource_group" {
+	is_default = "true"
+}
+resource "ibm_is_vpc" "vpc" {
+	name = "%[1]s"
+}
+resource "ibm_is_subnet" "subnet" {
+	name                     = "%[1]s"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "eu-de-1"
+	total_ipv4_address_count = 256
+}
+resource "ibm_container_vpc_cluster" "cluster" {
+	name                   = "%[1]s"
+	vpc_id                 = ibm_is_vpc.vpc.id
+	flavor                 = "cx2.2x4"
+	worker_count           = 2
+	kube_version           = "%[2]s"
+	update_all_workers     = %[3]t
+	patch_version          = "%[4]s"
+	wait_for_worker_update = %[5]t
+	wait_till              = "OneWorkerNodeReady"
+	resource_group_id      = data.ibm_resource_group.resource_group.id
+	zones {
+		 subnet_id = ibm_is_subnet.subnet.id
+		 name      = "eu-de-1"
+	}
+	update_strategy {
+		max_unavailable = 1
+		batch_delay     = 30
+	}
+  }`, name, kubeVersion, updateAllWorkers, patchVersion, waitForWorkerUpdate)
+}
@@ -35,6 +35,7 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -94,6 +95,20 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Computed: true,
 			},
 
+			"patch_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kubernetes patch version. Changing it replaces the workers of the worker pool whose kube version is behind the cluster master",
+			},
+
+			"retry_patch_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Argument which helps to retry the patch version updates on worker nodes. Increment the value to retry the patch updates if the previous apply fails",
+			},
+
+			"update_strategy": vpcWorkerUpdateStrategySchema(false),
+
 			"taints": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -136,10 +151,20 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				ForceNew:    true,
 			},
 			"worker_count": {
-				Type:        schema.TypeInt,
-				Required:    true,
+				Type:     schema.TypeInt,
+				Required: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// The cluster autoscaler owns the size of the worker pool
+					return o != "" && d.Get("autoscaler_managed").(bool)
+				},
 				Description: "The number of workers",
 			},
+			"autoscaler_managed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true when the worker pool is scaled by the cluster autoscaler, to ignore the changes of worker_count",
+			},
 			"entitlement": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -377,6 +402,23 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 			}
 		}
 	}
+	if (d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+		clusterNameOrID := d.Get("cluster").(string)
+		workerPoolName := d.Get("worker_pool_name").(string)
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+		err = expandVpcWorkerUpdateStrategy(d).updateWorkers(csClient.Workers(), clusterNameOrID, workerPoolName, targetEnv, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			d.Set("patch_version", nil)
+			return err
+		}
+	}
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
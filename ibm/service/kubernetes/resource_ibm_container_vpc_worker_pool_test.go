@@ -207,3 +207,88 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(name string) string {
 	}
 		`, name)
 }
+
+func TestAccIBMContainerVpcClusterWorkerPoolUpdateStrategy(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-worker-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(name, acc.KubeVersion, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_count", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.0.max_unavailable", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.0.batch_delay", "30"),
+				),
+			},
+			{
+				// Only the master is updated by the cluster, the workers of
+				// the pool are rolled one at a time by the patch_version change
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(name, acc.KubeUpdateVersion, "patch-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "patch_version", "patch-1"),
+					testAccCheckIBMContainerVpcWorkersUpdated("ibm_container_vpc_cluster.cluster", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(name, kubeVersion, patchVersion string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region="eu-de"
+	}
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+	resource "ibm_is_vpc" "vpc" {
+	  name = "%[1]s"
+	}
+
+	resource "ibm_is_subnet" "subnet1" {
+	  name                     = "%[1]s-1"
+	  vpc                      = ibm_is_vpc.vpc.id
+	  zone                     = "eu-de-1"
+	  total_ipv4_address_count = 256
+	}
+
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[1]s"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  flavor            = "cx2.2x4"
+	  worker_count      = 1
+	  kube_version      = "%[2]s"
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  wait_till         = "MasterNodeReady"
+	  zones {
+		subnet_id = ibm_is_subnet.subnet1.id
+		name      = "eu-de-1"
+	  }
+	}
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "cx2.2x4"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  worker_count      = 2
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  patch_version     = "%[3]s"
+	  zones {
+		name      = "eu-de-1"
+		subnet_id = ibm_is_subnet.subnet1.id
+	  }
+	  update_strategy {
+		max_unavailable = 1
+		batch_delay     = 30
+	  }
+	}
+		`, name, kubeVersion, patchVersion)
+}
@@ -0,0 +1,418 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+const (
+	workerActionReload  = "reload"
+	workerActionReplace = "replace"
+	workerActionReboot  = "reboot"
+
+	workerActionSucceeded = "succeeded"
+	workerActionFailed    = "failed"
+)
+
+func ResourceIBMContainerWorkerAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerWorkerActionCreate,
+		Read:   resourceIBMContainerWorkerActionRead,
+		Delete: resourceIBMContainerWorkerActionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.IfValue("action", func(_ context.Context, value, meta interface{}) bool {
+			return value.(string) != workerActionReplace
+		}, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			// A cluster created by the same apply is checked when the
+			// action runs
+			if !diff.NewValueKnown("cluster") || !diff.NewValueKnown("resource_group_id") {
+				return nil
+			}
+			csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+			if err != nil {
+				return err
+			}
+			targetEnv := v2.ClusterTargetHeader{ResourceGroup: diff.Get("resource_group_id").(string)}
+			return checkWorkerActionSupported(csClient.Clusters(), diff.Get("cluster").(string), diff.Get("action").(string), targetEnv)
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster Name or ID",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"worker_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"worker_ids", "label_selector"},
+				Description:  "The IDs of the workers to run the action on",
+			},
+			"label_selector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"worker_ids", "label_selector"},
+				Description:  "Run the action on the workers of the worker pools whose labels match the selector, such as env=prod,team!=ops",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{workerActionReload, workerActionReplace, workerActionReboot}, false),
+				Description:  "The action to run on the workers: reload, replace or reboot",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which run the action again when they change",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The result of the action for each worker",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker",
+						},
+						"new_worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker which replaces it, for the replace action",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "succeeded or failed",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error of the action, if it failed",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMContainerWorkerActionCreate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	clusterID := d.Get("cluster").(string)
+	action := d.Get("action").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	workers, err := selectWorkersForAction(d, csClient, clusterID, targetEnv)
+	if err != nil {
+		return err
+	}
+	if len(workers) == 0 {
+		return fmt.Errorf("[ERROR] No worker of cluster %s matches the worker_ids or label_selector", clusterID)
+	}
+
+	var v1Workers v1.Workers
+	if action != workerActionReplace {
+		if err := checkWorkerActionSupported(csClient.Clusters(), clusterID, action, targetEnv); err != nil {
+			return err
+		}
+		// Reload and reboot are actions of the v1 worker update API
+		csV1Client, err := meta.(conns.ClientSession).ContainerAPI()
+		if err != nil {
+			return err
+		}
+		v1Workers = csV1Client.Workers()
+	}
+
+	// The workers are handled one at a time, so that at most one of them is
+	// unavailable
+	results := make([]map[string]interface{}, 0, len(workers))
+	var failed []string
+	for _, worker := range workers {
+		result := map[string]interface{}{
+			"worker_id": worker.ID,
+			"status":    workerActionSucceeded,
+		}
+		var err error
+		if action == workerActionReplace {
+			var newWorkerID string
+			newWorkerID, err = replaceVpcWorker(csClient.Workers(), clusterID, worker, targetEnv, timeout)
+			result["new_worker_id"] = newWorkerID
+		} else {
+			err = updateWorkerWithAction(v1Workers, csClient.Workers(), clusterID, worker.ID, action, targetEnv, timeout)
+		}
+		if err != nil {
+			log.Printf("[ERROR] The %s of worker %s failed: %s", action, worker.ID, err)
+			result["status"] = workerActionFailed
+			result["message"] = err.Error()
+			failed = append(failed, worker.ID)
+		}
+		results = append(results, result)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterID, resource.UniqueId()))
+	d.Set("results", results)
+	if len(failed) > 0 {
+		return fmt.Errorf("[ERROR] The %s of the workers %s of cluster %s failed, see the results for the details", action, strings.Join(failed, ", "), clusterID)
+	}
+	return resourceIBMContainerWorkerActionRead(d, meta)
+}
+
+// checkWorkerActionSupported fails when the action is not supported by the
+// cluster. The worker update API which reloads and reboots workers only
+// manages the workers of classic clusters, and the v2 API has no such action.
+func checkWorkerActionSupported(client v2.Clusters, clusterID, action string, target v2.ClusterTargetHeader) error {
+	if action == workerActionReplace {
+		return nil
+	}
+	cls, err := client.GetCluster(clusterID, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the cluster %s: %s", clusterID, err)
+	}
+	if strings.HasPrefix(cls.Provider, "vpc") {
+		return fmt.Errorf("[ERROR] The %s action is not supported on the workers of the VPC cluster %s, use replace", action, clusterID)
+	}
+	return nil
+}
+
+// selectWorkersForAction returns the workers named by worker_ids, or the
+// workers of the worker pools matching label_selector.
+func selectWorkersForAction(d *schema.ResourceData, csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader) ([]v2.Worker, error) {
+	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	selected := make([]v2.Worker, 0, len(workers))
+	if ids, ok := d.GetOk("worker_ids"); ok {
+		byID := make(map[string]v2.Worker, len(workers))
+		for _, worker := range workers {
+			byID[worker.ID] = worker
+		}
+		for _, id := range flex.ExpandStringList(ids.(*schema.Set).List()) {
+			worker, ok := byID[id]
+			if !ok {
+				return nil, fmt.Errorf("[ERROR] Worker %s is not found in cluster %s", id, clusterID)
+			}
+			selected = append(selected, worker)
+		}
+	} else {
+		matches, err := parseWorkerLabelSelector(d.Get("label_selector").(string))
+		if err != nil {
+			return nil, err
+		}
+		pools, err := csClient.WorkerPools().ListWorkerPools(clusterID, targetEnv)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving worker pools of the cluster %s: %s", clusterID, err)
+		}
+		selectedPools := map[string]bool{}
+		for _, pool := range pools {
+			if matches(pool.Labels) {
+				selectedPools[pool.ID] = true
+			}
+		}
+		for _, worker := range workers {
+			if selectedPools[worker.PoolID] {
+				selected = append(selected, worker)
+			}
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].ID < selected[j].ID
+	})
+	return selected, nil
+}
+
+// parseWorkerLabelSelector parses an equality-based label selector, such as
+// env=prod,team!=ops.
+func parseWorkerLabelSelector(selector string) (func(map[string]string) bool, error) {
+	type requirement struct {
+		key, value string
+		equal      bool
+	}
+	var requirements []requirement
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		r := requirement{equal: true}
+		var parts []string
+		switch {
+		case strings.Contains(term, "!="):
+			parts = strings.SplitN(term, "!=", 2)
+			r.equal = false
+		case strings.Contains(term, "=="):
+			parts = strings.SplitN(term, "==", 2)
+		case strings.Contains(term, "="):
+			parts = strings.SplitN(term, "=", 2)
+		default:
+			return nil, fmt.Errorf("[ERROR] Invalid label_selector term %q, expected key=value or key!=value", term)
+		}
+		r.key, r.value = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if r.key == "" {
+			return nil, fmt.Errorf("[ERROR] Invalid label_selector term %q, the key is empty", term)
+		}
+		requirements = append(requirements, r)
+	}
+	if len(requirements) == 0 {
+		return nil, fmt.Errorf("[ERROR] The label_selector %q has no term", selector)
+	}
+	return func(labels map[string]string) bool {
+		for _, r := range requirements {
+			value, ok := labels[r.key]
+			if r.equal != (ok && value == r.value) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// replaceVpcWorker replaces the worker, and returns the ID of the worker which
+// replaces it once it is normal.
+func replaceVpcWorker(client v2.Workers, clusterID string, worker v2.Worker, target v2.ClusterTargetHeader, timeout time.Duration) (string, error) {
+	workers, err := client.ListWorkers(clusterID, false, target)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	known := make(map[string]bool, len(workers))
+	for _, w := range workers {
+		known[w.ID] = true
+	}
+
+	_, err = client.ReplaceWokerNode(clusterID, worker.ID, target)
+	// As API returns http response 204 NO CONTENT, error raised will be exempted.
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		return "", fmt.Errorf("[ERROR] Error replacing the worker node %s from the cluster: %s", worker.ID, err)
+	}
+	_, err = waitForVpcWorkerDeleted(client, clusterID, worker.ID, target, timeout)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"created"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := client.ListWorkers(clusterID, false, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+			}
+			for _, w := range workers {
+				if !known[w.ID] && w.PoolID == worker.PoolID && w.Location == worker.Location {
+					return w, "created", nil
+				}
+			}
+			return workers, "creating", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	newWorker, err := stateConf.WaitForState()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Failed to spawn the worker node replacing %s: %s", worker.ID, err)
+	}
+	newWorkerID := newWorker.(v2.Worker).ID
+	log.Println("found new replaced node: ", newWorkerID)
+	_, err = waitForVpcWorkerNormal(client, clusterID, newWorkerID, target, timeout)
+	if err != nil {
+		return newWorkerID, fmt.Errorf("[ERROR] Error waiting for cluster (%s) worker node %s to be normal: %s", clusterID, newWorkerID, err)
+	}
+	return newWorkerID, nil
+}
+
+// updateWorkerWithAction reloads or reboots the worker of a classic cluster,
+// and waits for it to be normal again.
+func updateWorkerWithAction(v1Client v1.Workers, client v2.Workers, clusterID, workerID, action string, target v2.ClusterTargetHeader, timeout time.Duration) error {
+	params := v1.WorkerUpdateParam{
+		Action: action,
+	}
+	err := v1Client.Update(clusterID, workerID, params, v1.ClusterTargetHeader{ResourceGroup: target.ResourceGroup})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error running the %s of worker %s: %s", action, workerID, err)
+	}
+
+	// Wait for the action to start, so that the worker is not found normal
+	// before it goes down
+	startConf := &resource.StateChangeConf{
+		Pending: []string{workerNormal},
+		Target:  []string{"started"},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := client.Get(clusterID, workerID, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving worker %s of cluster %s: %s", workerID, clusterID, err)
+			}
+			if worker.Health.State != workerNormal || worker.LifeCycle.PendingOperation != "" {
+				return worker, "started", nil
+			}
+			return worker, workerNormal, nil
+		},
+		Timeout:    5 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := startConf.WaitForState(); err != nil {
+		log.Printf("[WARN] The %s of worker %s was not seen starting: %s", action, workerID, err)
+	}
+
+	_, err = waitForVpcWorkerNormal(client, clusterID, workerID, target, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for cluster (%s) worker node %s to be normal: %s", clusterID, workerID, err)
+	}
+	return nil
+}
+
+func resourceIBMContainerWorkerActionRead(d *schema.ResourceData, meta interface{}) error {
+	// The action is run once when the resource is created, there is nothing
+	// to refresh
+	return nil
+}
+
+func resourceIBMContainerWorkerActionDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
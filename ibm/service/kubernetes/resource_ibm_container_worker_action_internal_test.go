@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeContainerService serves the workers, worker pools and clusters, the
+// other services panic.
+type fakeContainerService struct {
+	v2.ContainerServiceAPI
+	workers  *fakeWorkers
+	pools    []v2.GetWorkerPoolResponse
+	provider string
+}
+
+func (f *fakeContainerService) Workers() v2.Workers {
+	return f.workers
+}
+
+func (f *fakeContainerService) WorkerPools() v2.WorkerPool {
+	return fakeWorkerPools{pools: f.pools}
+}
+
+func (f *fakeContainerService) Clusters() v2.Clusters {
+	return fakeClusters{provider: f.provider}
+}
+
+type fakeWorkerPools struct {
+	v2.WorkerPool
+	pools []v2.GetWorkerPoolResponse
+}
+
+func (f fakeWorkerPools) ListWorkerPools(clusterNameOrID string, target v2.ClusterTargetHeader) ([]v2.GetWorkerPoolResponse, error) {
+	return f.pools, nil
+}
+
+type fakeClusters struct {
+	v2.Clusters
+	provider string
+}
+
+func (f fakeClusters) GetCluster(name string, target v2.ClusterTargetHeader) (*v2.ClusterInfo, error) {
+	return &v2.ClusterInfo{ID: name, Provider: f.provider}, nil
+}
+
+func TestParseWorkerLabelSelector(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "payments"}
+	cases := []struct {
+		selector string
+		matches  bool
+	}{
+		{"env=prod", true},
+		{"env==prod", true},
+		{" env = prod , team = payments ", true},
+		{"env=prod,team!=ops", true},
+		{"env=dev", false},
+		{"env!=prod", false},
+		{"zone!=eu-de-1", true},
+		{"zone=eu-de-1", false},
+		{"env=prod,,", true},
+	}
+	for _, c := range cases {
+		matches, err := parseWorkerLabelSelector(c.selector)
+		if err != nil {
+			t.Errorf("Expected the selector %q to be valid, got %s", c.selector, err)
+			continue
+		}
+		if matches(labels) != c.matches {
+			t.Errorf("Expected the selector %q to match %t", c.selector, c.matches)
+		}
+	}
+
+	for _, selector := range []string{"", " , ", "env", "=prod", "env in (prod)"} {
+		if _, err := parseWorkerLabelSelector(selector); err == nil {
+			t.Errorf("Expected the selector %q to be invalid", selector)
+		}
+	}
+}
+
+func TestSelectWorkersForAction(t *testing.T) {
+	csClient := &fakeContainerService{
+		workers: &fakeWorkers{
+			workers: []v2.Worker{
+				fakeWorker("w3", "edge", workerNormal, false),
+				fakeWorker("w2", "default", workerNormal, false),
+				fakeWorker("w1", "edge", workerNormal, false),
+			},
+		},
+		pools: []v2.GetWorkerPoolResponse{
+			{ID: "default-id", PoolName: "default", Labels: map[string]string{"env": "prod"}},
+			{ID: "edge-id", PoolName: "edge", Labels: map[string]string{"env": "prod", "edge": "true"}},
+		},
+	}
+	selectWorkers := func(t *testing.T, config map[string]interface{}) ([]string, error) {
+		d := schema.TestResourceDataRaw(t, ResourceIBMContainerWorkerAction().Schema, config)
+		workers, err := selectWorkersForAction(d, csClient, "cluster", v2.ClusterTargetHeader{})
+		ids := make([]string, 0, len(workers))
+		for _, worker := range workers {
+			ids = append(ids, worker.ID)
+		}
+		return ids, err
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected []string
+	}{
+		{"worker IDs", map[string]interface{}{"worker_ids": []interface{}{"w3", "w2"}}, []string{"w2", "w3"}},
+		{"label selector", map[string]interface{}{"label_selector": "edge=true"}, []string{"w1", "w3"}},
+		{"all pools", map[string]interface{}{"label_selector": "env=prod"}, []string{"w1", "w2", "w3"}},
+		{"no match", map[string]interface{}{"label_selector": "env=dev"}, []string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ids, err := selectWorkers(t, c.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ids, c.expected) {
+				t.Errorf("Expected the workers %v, got %v", c.expected, ids)
+			}
+		})
+	}
+
+	_, err := selectWorkers(t, map[string]interface{}{"worker_ids": []interface{}{"w1", "w4"}})
+	if err == nil || !strings.Contains(err.Error(), "w4 is not found") {
+		t.Errorf("Expected the unknown worker to fail, got %v", err)
+	}
+}
+
+func TestCheckWorkerActionSupported(t *testing.T) {
+	cases := []struct {
+		provider, action string
+		supported        bool
+	}{
+		{"classic", workerActionReboot, true},
+		{"classic", workerActionReload, true},
+		{"vpc-gen2", workerActionReplace, true},
+		{"vpc-gen2", workerActionReboot, false},
+		{"vpc-gen2", workerActionReload, false},
+		{"vpc-classic", workerActionReboot, false},
+	}
+	for _, c := range cases {
+		err := checkWorkerActionSupported(fakeClusters{provider: c.provider}, "cluster", c.action, v2.ClusterTargetHeader{})
+		if (err == nil) != c.supported {
+			t.Errorf("Expected the %s of %s workers to be supported: %t, got %v", c.action, c.provider, c.supported, err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerWorkerAction_Replace(t *testing.T) {
+	name := fmt.Sprintf("tf-worker-action-%d", acctest.RandIntRange(10, 100))
+	node := "ibm_container_worker_action.action"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorkerActionConfig(name, "replace", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "results.#", "1"),
+					resource.TestCheckResourceAttr(node, "results.0.status", "succeeded"),
+					resource.TestCheckResourceAttrSet(node, "results.0.new_worker_id"),
+				),
+			},
+			{
+				// The workers of VPC clusters can only be replaced
+				Config:      testAccCheckIBMContainerWorkerActionConfig(name, "reboot", "2"),
+				ExpectError: regexp.MustCompile("not supported on the workers of the VPC cluster"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerWorkerActionConfig(name, action, run string) string {
+	return fmt.Sprintf(`
+	provider "ibm"{
+		region = "eu-de"
+	}
+	resource "ibm_is_vpc" "vpc" {
+		name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet" {
+		name                     = "%[1]s"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "eu-de-1"
+		total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = ibm_is_vpc.vpc.id
+		flavor            = "cx2.2x4"
+		worker_count      = 1
+		wait_till         = "OneWorkerNodeReady"
+		worker_labels = {
+			"worker-action" = "test"
+		}
+		zones {
+			subnet_id = ibm_is_subnet.subnet.id
+			name      = "eu-de-1"
+		}
+	}
+	resource "ibm_container_worker_action" "action" {
+		cluster        = ibm_container_vpc_cluster.cluster.id
+		label_selector = "worker-action=test"
+		action         = "%[2]s"
+		triggers = {
+			run = "%[3]s"
+		}
+	}`, name, action, run)
+}
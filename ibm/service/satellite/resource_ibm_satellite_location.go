@@ -61,7 +61,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ImmutableResourceCustomizeDiff([]string{satLocation, sateLocZone, "resource_group_id", "zones"}, diff)
@@ -167,6 +167,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags associated with resource instance",
 			},
+			"tags_all": flex.TagsAllSchema(),
 			flex.ResourceGroupName: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -274,7 +275,7 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Created satellite location : %s", satLocation)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -331,6 +332,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 			"Error on get of ibm satellite location tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	d.Set("crn", *instance.Crn)
 	d.Set(flex.ResourceGroupName, *instance.ResourceGroupName)
 	if instance.Hosts != nil {
@@ -354,7 +356,7 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange("tags_all") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
 			Controller: &ID,
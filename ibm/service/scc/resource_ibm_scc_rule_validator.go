@@ -9,7 +9,7 @@ import (
 
 func ResourceIBMSccRuleValidator() *validate.ResourceValidator {
 
-	validateSchemaList := make([]validate.ValidateSchema, 2)
+	validateSchemaList := make([]validate.ValidateSchema, 0)
 	validateSchemaList = append(validateSchemaList, validateIBMSccRuleReqConfig())
 	resourceValidator := validate.ResourceValidator{
 		ResourceName: "ibm_scc_rule",
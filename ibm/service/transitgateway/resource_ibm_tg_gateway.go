@@ -55,7 +55,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -91,6 +91,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the transit gateway instance",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			tgResourceGroup: {
 				Type:     schema.TypeString,
@@ -221,7 +222,7 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
@@ -303,6 +304,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 			"Error on get of transit gateway (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(tgGatewayTags, tags)
+	d.Set("tags_all", tags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -353,7 +355,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 			updateTransitGatewayOptions.Global = &global
 		}
 	}
-	if d.HasChange(tgGatewayTags) {
+	if d.HasChange(tgGatewayTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
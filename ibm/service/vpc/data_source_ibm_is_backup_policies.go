@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsBackupPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsBackupPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the backup policies with the exact name.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the backup policies of the resource group with this identifier.",
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the backup policies with an item in their match_user_tags property matching the exact tag.",
+			},
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterLifecycleState),
+			"backup_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of backup policies.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the backup policy was created.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN for this backup policy.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this backup policy.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this backup policy.",
+						},
+						"last_job_completed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the most recent job for this backup policy completed.",
+						},
+						"lifecycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the backup policy.",
+						},
+						"match_resource_types": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Set:         schema.HashString,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The resource types this backup policy applies to.",
+						},
+						"match_user_tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Set:         schema.HashString,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags this backup policy applies to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this backup policy.",
+						},
+						"plans": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The plans of the backup policy.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"href": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The URL for this backup policy plan.",
+									},
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for this backup policy plan.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique user-defined name for this backup policy plan.",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of resource referenced.",
+									},
+								},
+							},
+						},
+						"resource_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource group of this backup policy.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of backup policies.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsBackupPoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listBackupPoliciesOptions := &vpcv1.ListBackupPoliciesOptions{}
+	if v, ok := d.GetOk("name"); ok {
+		listBackupPoliciesOptions.SetName(v.(string))
+	}
+	if v, ok := d.GetOk("resource_group"); ok {
+		listBackupPoliciesOptions.SetResourceGroupID(v.(string))
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		listBackupPoliciesOptions.SetTag(v.(string))
+	}
+	start := ""
+	allrecs := []vpcv1.BackupPolicy{}
+	for {
+		if start != "" {
+			listBackupPoliciesOptions.Start = &start
+		}
+		backupPolicyCollection, response, err := vpcClient.ListBackupPoliciesWithContext(context, listBackupPoliciesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListBackupPoliciesWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListBackupPolicies", err, response)
+		}
+		start = flex.GetNext(backupPolicyCollection.Next)
+		allrecs = append(allrecs, backupPolicyCollection.BackupPolicies...)
+		if start == "" {
+			break
+		}
+	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(backupPolicy vpcv1.BackupPolicy) isFilterAttributes {
+		return isFilterAttributes{CRN: backupPolicy.CRN, Name: backupPolicy.Name, ResourceGroup: backupPolicy.ResourceGroup, LifecycleState: backupPolicy.LifecycleState}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicies := make([]map[string]interface{}, 0, len(allrecs))
+	for _, backupPolicy := range allrecs {
+		backupPolicies = append(backupPolicies, dataSourceIBMIsBackupPoliciesToMap(backupPolicy))
+	}
+
+	d.SetId(dataSourceIBMIsBackupPoliciesID(d))
+	if err = d.Set("backup_policies", backupPolicies); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting backup_policies %s", err))
+	}
+	if err = d.Set("total_count", len(allrecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_count: %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsBackupPoliciesID returns a reasonable ID for the list.
+func dataSourceIBMIsBackupPoliciesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceIBMIsBackupPoliciesToMap(backupPolicy vpcv1.BackupPolicy) map[string]interface{} {
+	backupPolicyMap := map[string]interface{}{}
+
+	if backupPolicy.CreatedAt != nil {
+		backupPolicyMap["created_at"] = backupPolicy.CreatedAt.String()
+	}
+	if backupPolicy.CRN != nil {
+		backupPolicyMap["crn"] = backupPolicy.CRN
+	}
+	if backupPolicy.Href != nil {
+		backupPolicyMap["href"] = backupPolicy.Href
+	}
+	if backupPolicy.ID != nil {
+		backupPolicyMap["id"] = backupPolicy.ID
+	}
+	if backupPolicy.LastJobCompletedAt != nil {
+		backupPolicyMap["last_job_completed_at"] = backupPolicy.LastJobCompletedAt.String()
+	}
+	if backupPolicy.LifecycleState != nil {
+		backupPolicyMap["lifecycle_state"] = backupPolicy.LifecycleState
+	}
+	backupPolicyMap["match_resource_types"] = flex.NewStringSet(schema.HashString, backupPolicy.MatchResourceTypes)
+	backupPolicyMap["match_user_tags"] = flex.NewStringSet(schema.HashString, backupPolicy.MatchUserTags)
+	if backupPolicy.Name != nil {
+		backupPolicyMap["name"] = backupPolicy.Name
+	}
+	plans := []map[string]interface{}{}
+	for _, plan := range backupPolicy.Plans {
+		planMap := map[string]interface{}{}
+		if plan.Href != nil {
+			planMap["href"] = plan.Href
+		}
+		if plan.ID != nil {
+			planMap["id"] = plan.ID
+		}
+		if plan.Name != nil {
+			planMap["name"] = plan.Name
+		}
+		if plan.ResourceType != nil {
+			planMap["resource_type"] = plan.ResourceType
+		}
+		plans = append(plans, planMap)
+	}
+	backupPolicyMap["plans"] = plans
+	if backupPolicy.ResourceGroup != nil && backupPolicy.ResourceGroup.ID != nil {
+		backupPolicyMap["resource_group"] = backupPolicy.ResourceGroup.ID
+	}
+	if backupPolicy.ResourceType != nil {
+		backupPolicyMap["resource_type"] = backupPolicy.ResourceType
+	}
+
+	return backupPolicyMap
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsBackupPoliciesDataSourceBasic(t *testing.T) {
+	backupPolicyName := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsBackupPoliciesDataSourceConfigBasic(backupPolicyName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policies.is_backup_policies", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policies.is_backup_policies", "backup_policies.0.name", backupPolicyName),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policies.is_backup_policies", "backup_policies.0.plans.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policy_plans.is_backup_policy_plans", "plans.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policy_plans.is_backup_policy_plans", "plans.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsBackupPoliciesDataSourceConfigBasic(backupPolicyName, name string) string {
+	return testAccCheckIBMIsBackupPolicyPlanConfigBasic(backupPolicyName, name, "30 09 * * *", 20, "", `["tf-backup-plan"]`) + `
+	data "ibm_is_backup_policies" "is_backup_policies" {
+		name       = ibm_is_backup_policy.is_backup_policy.name
+		depends_on = [ibm_is_backup_policy_plan.is_backup_policy_plan]
+	}
+
+	data "ibm_is_backup_policy_plans" "is_backup_policy_plans" {
+		backup_policy_id = ibm_is_backup_policy.is_backup_policy.id
+		depends_on       = [ibm_is_backup_policy_plan.is_backup_policy_plan]
+	}
+	`
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsBackupPolicyPlans() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsBackupPolicyPlansRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the backup policy.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the backup policy plans with the exact name.",
+			},
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
+			"plans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of backup policy plans.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the plan is active.",
+						},
+						"attach_user_tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Set:         schema.HashString,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags to attach to each backup (snapshot) created by this plan.",
+						},
+						"copy_user_tags": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether to copy the source's user tags to the created backups (snapshots).",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the backup policy plan was created.",
+						},
+						"cron_spec": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cron specification for the backup schedule.",
+						},
+						"deletion_trigger": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The retention of the backups (snapshots) created by this plan.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_after": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The maximum number of days to keep each backup after creation.",
+									},
+									"delete_over_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The maximum number of recent backups to keep.",
+									},
+								},
+							},
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this backup policy plan.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this backup policy plan.",
+						},
+						"lifecycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of this backup policy plan.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique user-defined name for this backup policy plan.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsBackupPolicyPlansRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listBackupPolicyPlansOptions := &vpcv1.ListBackupPolicyPlansOptions{}
+	listBackupPolicyPlansOptions.SetBackupPolicyID(d.Get("backup_policy_id").(string))
+	if v, ok := d.GetOk("name"); ok {
+		listBackupPolicyPlansOptions.SetName(v.(string))
+	}
+
+	backupPolicyPlanCollection, response, err := vpcClient.ListBackupPolicyPlansWithContext(context, listBackupPolicyPlansOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListBackupPolicyPlansWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "ListBackupPolicyPlans", err, response)
+	}
+	backupPolicyPlanCollection.Plans, err = filterIBMIsResources(meta, filters, backupPolicyPlanCollection.Plans, func(plan vpcv1.BackupPolicyPlan) isFilterAttributes {
+		return isFilterAttributes{Name: plan.Name, LifecycleState: plan.LifecycleState}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	plans := make([]map[string]interface{}, 0, len(backupPolicyPlanCollection.Plans))
+	for _, plan := range backupPolicyPlanCollection.Plans {
+		planMap := map[string]interface{}{
+			"active":           plan.Active,
+			"attach_user_tags": flex.NewStringSet(schema.HashString, plan.AttachUserTags),
+			"copy_user_tags":   plan.CopyUserTags,
+			"cron_spec":        plan.CronSpec,
+			"deletion_trigger": resourceIBMIsBackupPolicyPlanFlattenDeletionTrigger(plan.DeletionTrigger),
+			"href":             plan.Href,
+			"id":               plan.ID,
+			"lifecycle_state":  plan.LifecycleState,
+			"name":             plan.Name,
+			"resource_type":    plan.ResourceType,
+		}
+		if plan.CreatedAt != nil {
+			planMap["created_at"] = plan.CreatedAt.String()
+		}
+		plans = append(plans, planMap)
+	}
+
+	d.SetId(dataSourceIBMIsBackupPolicyPlansID(d))
+	if err = d.Set("plans", plans); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting plans %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsBackupPolicyPlansID returns a reasonable ID for the list.
+func dataSourceIBMIsBackupPolicyPlansID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -25,6 +25,7 @@ func DataSourceIBMIsBareMetalServerDisks() *schema.Resource {
 		ReadContext: dataSourceIBMISBareMetalServerDisksRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName),
 			isBareMetalServerID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -82,6 +83,10 @@ func dataSourceIBMISBareMetalServerDisksRead(context context.Context, d *schema.
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	options := &vpcv1.ListBareMetalServerDisksOptions{
 		BareMetalServerID: &bareMetalServerID,
 	}
@@ -91,6 +96,12 @@ func dataSourceIBMISBareMetalServerDisksRead(context context.Context, d *schema.
 	if err != nil || disks == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) disks: %s\n%s", bareMetalServerID, err, response))
 	}
+	disks, err = filterIBMIsResources(meta, filters, disks, func(disk vpcv1.BareMetalServerDisk) isFilterAttributes {
+		return isFilterAttributes{Name: disk.Name}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	disksInfo := make([]map[string]interface{}, 0)
 	for _, disk := range disks {
 		l := map[string]interface{}{
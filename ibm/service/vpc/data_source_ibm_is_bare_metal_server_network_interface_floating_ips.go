@@ -24,6 +24,7 @@ func DataSourceIBMIsBareMetalServerNetworkInterfaceFloatingIPs() *schema.Resourc
 		ReadContext: dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterLifecycleState),
 			isBareMetalServerID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -96,6 +97,10 @@ func dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPsRead(context conte
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	allFloatingIPs := []vpcv1.FloatingIP{}
 	options := &vpcv1.ListBareMetalServerNetworkInterfaceFloatingIpsOptions{
 		BareMetalServerID:  &bareMetalServerID,
@@ -107,6 +112,12 @@ func dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPsRead(context conte
 		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching floating IPs for bare metal server %s\n%s", err, response))
 	}
 	allFloatingIPs = append(allFloatingIPs, fips.FloatingIps...)
+	allFloatingIPs, err = filterIBMIsResources(meta, filters, allFloatingIPs, func(floatingIP vpcv1.FloatingIP) isFilterAttributes {
+		return isFilterAttributes{CRN: floatingIP.CRN, Name: floatingIP.Name, ResourceGroup: floatingIP.ResourceGroup, Zone: floatingIP.Zone, LifecycleState: floatingIP.Status}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	fipInfo := make([]map[string]interface{}, 0)
 	for _, ip := range allFloatingIPs {
 		l := map[string]interface{}{}
@@ -21,6 +21,7 @@ func DataSourceIBMIsBareMetalServerNetworkInterfaces() *schema.Resource {
 		ReadContext: dataSourceIBMISBareMetalServerNetworkInterfacesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			isBareMetalServerID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -209,6 +210,10 @@ func dataSourceIBMISBareMetalServerNetworkInterfacesRead(context context.Context
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	options := &vpcv1.ListBareMetalServerNetworkInterfacesOptions{
 		BareMetalServerID: &bareMetalServerID,
 	}
@@ -218,6 +223,18 @@ func dataSourceIBMISBareMetalServerNetworkInterfacesRead(context context.Context
 		return diag.FromErr(fmt.Errorf("[ERROR] Error listing Bare Metal Server (%s) network interfaces : %s\n%s", bareMetalServerID, err, response))
 	}
 	nics = append(nics, bmsNics.NetworkInterfaces...)
+	nics, err = filterIBMIsResources(meta, filters, nics, func(nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf) isFilterAttributes {
+		switch nic := nicIntf.(type) {
+		case *vpcv1.BareMetalServerNetworkInterfaceByPci:
+			return isFilterAttributes{Name: nic.Name, LifecycleState: nic.Status}
+		case *vpcv1.BareMetalServerNetworkInterfaceByVlan:
+			return isFilterAttributes{Name: nic.Name, LifecycleState: nic.Status}
+		}
+		return isFilterAttributes{}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	nicsInfo := make([]map[string]interface{}, 0)
 	for _, nicIntf := range nics {
 		l := map[string]interface{}{}
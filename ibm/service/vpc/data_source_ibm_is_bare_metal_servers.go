@@ -27,6 +27,7 @@ func DataSourceIBMIsBareMetalServers() *schema.Resource {
 		ReadContext: dataSourceIBMISBareMetalServersRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterVPC, isFilterLifecycleState),
 
 			isBareMetalServers: {
 				Type:        schema.TypeList,
@@ -384,10 +385,20 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	start := ""
 	allrecs := []vpcv1.BareMetalServer{}
 	for {
 		listBareMetalServersOptions := &vpcv1.ListBareMetalServersOptions{}
+		if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+			listBareMetalServersOptions.ResourceGroupID = &resourceGroup
+		}
+		if vpc, ok := filters.Value(isFilterVPC); ok {
+			listBareMetalServersOptions.VPCID = &vpc
+		}
 		if start != "" {
 			listBareMetalServersOptions.Start = &start
 		}
@@ -402,6 +413,13 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(bareMetalServer vpcv1.BareMetalServer) isFilterAttributes {
+		return isFilterAttributes{CRN: bareMetalServer.CRN, Name: bareMetalServer.Name, ResourceGroup: bareMetalServer.ResourceGroup, Zone: bareMetalServer.Zone, VPC: bareMetalServer.VPC, LifecycleState: bareMetalServer.Status}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	serversInfo := make([]map[string]interface{}, 0)
 	for _, bms := range allrecs {
 
@@ -21,6 +21,7 @@ func DataSourceIbmIsDedicatedHostDisks() *schema.Resource {
 		ReadContext: dataSourceIbmIsDedicatedHostDisksRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			"dedicated_host": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -146,6 +147,11 @@ func dataSourceIbmIsDedicatedHostDisksRead(context context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	listDedicatedHostDisksOptions := &vpcv1.ListDedicatedHostDisksOptions{}
 
 	listDedicatedHostDisksOptions.SetDedicatedHostID(d.Get("dedicated_host").(string))
@@ -155,6 +161,12 @@ func dataSourceIbmIsDedicatedHostDisksRead(context context.Context, d *schema.Re
 		log.Printf("[DEBUG] ListDedicatedHostDisksWithContext failed %s\n%s", err, response)
 		return diag.FromErr(err)
 	}
+	dedicatedHostDiskCollection.Disks, err = filterIBMIsResources(meta, filters, dedicatedHostDiskCollection.Disks, func(disk vpcv1.DedicatedHostDisk) isFilterAttributes {
+		return isFilterAttributes{Name: disk.Name, LifecycleState: disk.LifecycleState}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(dataSourceIbmIsDedicatedHostDisksID(d))
 
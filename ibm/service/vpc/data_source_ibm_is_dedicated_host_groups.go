@@ -22,6 +22,7 @@ func DataSourceIbmIsDedicatedHostGroups() *schema.Resource {
 		ReadContext: dataSourceIbmIsDedicatedHostGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone),
 			"host_groups": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -162,7 +163,17 @@ func dataSourceIbmIsDedicatedHostGroupsRead(context context.Context, d *schema.R
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	listDedicatedHostGroupsOptions := &vpcv1.ListDedicatedHostGroupsOptions{}
+	if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+		listDedicatedHostGroupsOptions.ResourceGroupID = &resourceGroup
+	}
+	if zone, ok := filters.Value(isFilterZone); ok {
+		listDedicatedHostGroupsOptions.ZoneName = &zone
+	}
 
 	start := ""
 	allrecs := []vpcv1.DedicatedHostGroup{}
@@ -182,6 +193,13 @@ func dataSourceIbmIsDedicatedHostGroupsRead(context context.Context, d *schema.R
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(dedicatedHostGroup vpcv1.DedicatedHostGroup) isFilterAttributes {
+		return isFilterAttributes{CRN: dedicatedHostGroup.CRN, Name: dedicatedHostGroup.Name, ResourceGroup: dedicatedHostGroup.ResourceGroup, Zone: dedicatedHostGroup.Zone}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(allrecs) != 0 {
 
 		d.SetId(dataSourceIbmIsDedicatedHostGroupsID(d))
@@ -22,6 +22,7 @@ func DataSourceIbmIsDedicatedHosts() *schema.Resource {
 		ReadContext: dataSourceIbmIsDedicatedHostsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterLifecycleState),
 			"host_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -360,7 +361,17 @@ func dataSourceIbmIsDedicatedHostsRead(context context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	listDedicatedHostsOptions := &vpcv1.ListDedicatedHostsOptions{}
+	if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+		listDedicatedHostsOptions.ResourceGroupID = &resourceGroup
+	}
+	if zone, ok := filters.Value(isFilterZone); ok {
+		listDedicatedHostsOptions.ZoneName = &zone
+	}
 	if hostgroupintf, ok := d.GetOk("host_group"); ok {
 		hostgroupid := hostgroupintf.(string)
 		listDedicatedHostsOptions.DedicatedHostGroupID = &hostgroupid
@@ -383,6 +394,13 @@ func dataSourceIbmIsDedicatedHostsRead(context context.Context, d *schema.Resour
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(dedicatedHost vpcv1.DedicatedHost) isFilterAttributes {
+		return isFilterAttributes{CRN: dedicatedHost.CRN, Name: dedicatedHost.Name, ResourceGroup: dedicatedHost.ResourceGroup, Zone: dedicatedHost.Zone, LifecycleState: dedicatedHost.LifecycleState}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(allrecs) > 0 {
 
 		d.SetId(dataSourceIbmIsDedicatedHostsID(d))
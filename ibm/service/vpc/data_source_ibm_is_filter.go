@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// The names of the filters of the plural data sources.
+const (
+	isFilterLifecycleState = "lifecycle_state"
+	isFilterName           = "name"
+	isFilterResourceGroup  = "resource_group"
+	isFilterTag            = "tag"
+	isFilterVPC            = "vpc"
+	isFilterZone           = "zone"
+)
+
+// dataSourceIBMIsFilterSchema returns the filter block of a plural data source
+// which supports the given filter names.
+func dataSourceIBMIsFilterSchema(names ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Filters the listed resources. A resource is listed if it matches all the filters, and it matches a filter if it matches one of its values.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(names, false),
+					Description:  "The name of the filter.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The values of the filter. The values of the name filter are regular expressions.",
+				},
+			},
+		},
+	}
+}
+
+// isFilterAttributes are the attributes of a listed resource the filters
+// match, nil if the resource doesn't have the attribute.
+type isFilterAttributes struct {
+	CRN           *string
+	Name          *string
+	ResourceGroup *vpcv1.ResourceGroupReference
+	Zone          *vpcv1.ZoneReference
+	VPC           *vpcv1.VPCReference
+	// LifecycleState is the status of the resources which don't have a
+	// lifecycle state.
+	LifecycleState *string
+	// UserTags are the user tags returned by the list call, if it does.
+	// Otherwise, the tags are read from the global tagging API.
+	UserTags []string
+}
+
+// isDataSourceFilters are the filter blocks of a plural data source.
+type isDataSourceFilters struct {
+	values map[string][]string
+	names  []*regexp.Regexp
+}
+
+func expandIBMIsDataSourceFilters(d *schema.ResourceData) (*isDataSourceFilters, error) {
+	filters := &isDataSourceFilters{
+		values: map[string][]string{},
+	}
+	for _, filterIntf := range d.Get("filter").(*schema.Set).List() {
+		filter := filterIntf.(map[string]interface{})
+		name := filter["name"].(string)
+		values := flex.ExpandStringList(filter["values"].([]interface{}))
+		filters.values[name] = append(filters.values[name], values...)
+		if name == isFilterName {
+			for _, value := range values {
+				re, err := regexp.Compile(value)
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Error compiling the name filter %q: %s", value, err)
+				}
+				filters.names = append(filters.names, re)
+			}
+		}
+	}
+	return filters, nil
+}
+
+// Value returns the value of the filter if it has a single one, to push it
+// down to the list call.
+func (filters *isDataSourceFilters) Value(name string) (string, bool) {
+	if values := filters.values[name]; len(values) == 1 {
+		return values[0], true
+	}
+	return "", false
+}
+
+// Match returns whether the resource with the attributes matches all the filters.
+func (filters *isDataSourceFilters) Match(meta interface{}, attrs isFilterAttributes) (bool, error) {
+	if len(filters.values) == 0 {
+		return true, nil
+	}
+	if len(filters.names) > 0 {
+		matched := false
+		for _, re := range filters.names {
+			if attrs.Name != nil && re.MatchString(*attrs.Name) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	if values, ok := filters.values[isFilterResourceGroup]; ok {
+		if attrs.ResourceGroup == nil || !isFilterContains(values, attrs.ResourceGroup.ID) {
+			return false, nil
+		}
+	}
+	if values, ok := filters.values[isFilterZone]; ok {
+		if attrs.Zone == nil || !isFilterContains(values, attrs.Zone.Name) {
+			return false, nil
+		}
+	}
+	if values, ok := filters.values[isFilterVPC]; ok {
+		if attrs.VPC == nil || !isFilterContains(values, attrs.VPC.ID) {
+			return false, nil
+		}
+	}
+	if values, ok := filters.values[isFilterLifecycleState]; ok {
+		if !isFilterContains(values, attrs.LifecycleState) {
+			return false, nil
+		}
+	}
+	// The tags are matched last, they may need an API call
+	if values, ok := filters.values[isFilterTag]; ok {
+		tags := attrs.UserTags
+		if tags == nil && attrs.CRN != nil {
+			tagSet, err := flex.GetGlobalTagsUsingCRN(meta, *attrs.CRN, "", isUserTagType)
+			if err != nil {
+				return false, fmt.Errorf("[ERROR] Error getting the tags of %s: %s", *attrs.CRN, err)
+			}
+			tags = flex.ExpandStringList(tagSet.List())
+		}
+		matched := false
+		for _, tag := range tags {
+			if isFilterContains(values, &tag) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func isFilterContains(values []string, value *string) bool {
+	if value == nil {
+		return false
+	}
+	for _, v := range values {
+		if v == *value {
+			return true
+		}
+	}
+	return false
+}
+
+// filterIBMIsResources returns the resources of recs matching the filters,
+// reusing the backing array of recs. attrs returns the attributes the filters
+// match of a resource.
+func filterIBMIsResources[T any](meta interface{}, filters *isDataSourceFilters, recs []T, attrs func(T) isFilterAttributes) ([]T, error) {
+	i := 0
+	for _, rec := range recs {
+		match, err := filters.Match(meta, attrs(rec))
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			recs[i] = rec
+			i++
+		}
+	}
+	return recs[:i], nil
+}
@@ -22,6 +22,7 @@ func DataSourceIBMIsFloatingIps() *schema.Resource {
 		ReadContext: dataSourceIBMIsFloatingIpsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterLifecycleState),
 			"name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -210,10 +211,17 @@ func dataSourceIBMIsFloatingIpsRead(context context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	start := ""
 	allFloatingIPs := []vpcv1.FloatingIP{}
 	for {
 		floatingIPOptions := &vpcv1.ListFloatingIpsOptions{}
+		if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+			floatingIPOptions.ResourceGroupID = &resourceGroup
+		}
 		if start != "" {
 			floatingIPOptions.Start = &start
 		}
@@ -228,6 +236,13 @@ func dataSourceIBMIsFloatingIpsRead(context context.Context, d *schema.ResourceD
 			break
 		}
 	}
+
+	allFloatingIPs, err = filterIBMIsResources(meta, filters, allFloatingIPs, func(floatingIP vpcv1.FloatingIP) isFilterAttributes {
+		return isFilterAttributes{CRN: floatingIP.CRN, Name: floatingIP.Name, ResourceGroup: floatingIP.ResourceGroup, Zone: floatingIP.Zone, LifecycleState: floatingIP.Status}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	var matchFloatingIps []vpcv1.FloatingIP
 	var name string
 	var suppliedFilter bool
@@ -21,6 +21,7 @@ func DataSourceIBMISFlowLogs() *schema.Resource {
 		Read: dataSourceIBMISFlowLogsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterVPC, isFilterLifecycleState),
 
 			isFlowLogs: {
 				Type:        schema.TypeList,
@@ -96,10 +97,20 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	start := ""
 	allrecs := []vpcv1.FlowLogCollector{}
 	for {
 		listOptions := &vpcv1.ListFlowLogCollectorsOptions{}
+		if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+			listOptions.ResourceGroupID = &resourceGroup
+		}
+		if vpc, ok := filters.Value(isFilterVPC); ok {
+			listOptions.VPCID = &vpc
+		}
 		if start != "" {
 			listOptions.Start = &start
 		}
@@ -113,6 +124,13 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 			break
 		}
 	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(flowlogCollector vpcv1.FlowLogCollector) isFilterAttributes {
+		return isFilterAttributes{CRN: flowlogCollector.CRN, Name: flowlogCollector.Name, ResourceGroup: flowlogCollector.ResourceGroup, VPC: flowlogCollector.VPC, LifecycleState: flowlogCollector.LifecycleState}
+	})
+	if err != nil {
+		return err
+	}
 	flowlogsInfo := make([]map[string]interface{}, 0)
 	for _, flowlogCollector := range allrecs {
 
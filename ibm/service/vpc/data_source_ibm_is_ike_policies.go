@@ -22,6 +22,7 @@ func DataSourceIBMIsIkePolicies() *schema.Resource {
 		ReadContext: dataSourceIBMIsIkePoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterResourceGroup),
 			"ike_policies": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -162,6 +163,10 @@ func dataSourceIBMIsIkePoliciesRead(context context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	start := ""
 	allrecs := []vpcv1.IkePolicy{}
@@ -182,6 +187,13 @@ func dataSourceIBMIsIkePoliciesRead(context context.Context, d *schema.ResourceD
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(policy vpcv1.IkePolicy) isFilterAttributes {
+		return isFilterAttributes{Name: policy.Name, ResourceGroup: policy.ResourceGroup}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(dataSourceIBMIsIkePoliciesID(d))
 
 	err = d.Set("ike_policies", dataSourceIkePolicyCollectionFlattenIkePolicies(allrecs))
@@ -49,3 +49,39 @@ func testAccCheckIBMIsIkePoliciesDataSourceConfigBasic(name string) string {
 		}
 	`, name)
 }
+
+func TestAccIBMIsIkePoliciesDataSourceFilter(t *testing.T) {
+	name := fmt.Sprintf("tfike-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsIkePoliciesDataSourceConfigFilter(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_ike_policies.is_ike_policies", "ike_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_ike_policies.is_ike_policies", "ike_policies.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsIkePoliciesDataSourceConfigFilter(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_ike_policy" "example" {
+			name = "%s"
+			authentication_algorithm = "sha1"
+			encryption_algorithm = "aes128"
+			dh_group = 5
+			ike_version = 2
+			key_lifetime = 1800
+		}
+		data "ibm_is_ike_policies" "is_ike_policies" {
+			filter {
+				name   = "name"
+				values = ["^${ibm_is_ike_policy.example.name}$"]
+			}
+		}
+	`, name)
+}
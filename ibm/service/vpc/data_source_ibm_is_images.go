@@ -23,6 +23,7 @@ func DataSourceIBMISImages() *schema.Resource {
 		Read: dataSourceIBMISImagesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterLifecycleState),
 			isImagesResourceGroupID: {
 				Type:        schema.TypeString,
 				Description: "The id of the resource group",
@@ -140,7 +141,15 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		visibility = v.(string)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
+
 	listImagesOptions := &vpcv1.ListImagesOptions{}
+	if v, ok := filters.Value(isFilterResourceGroup); ok && resourceGroupID == "" {
+		resourceGroupID = v
+	}
 	if resourceGroupID != "" {
 		listImagesOptions.SetResourceGroupID(resourceGroupID)
 	}
@@ -165,6 +174,13 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 			break
 		}
 	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(image vpcv1.Image) isFilterAttributes {
+		return isFilterAttributes{CRN: image.CRN, Name: image.Name, ResourceGroup: image.ResourceGroup, LifecycleState: image.Status}
+	})
+	if err != nil {
+		return err
+	}
 	imagesInfo := make([]map[string]interface{}, 0)
 	for _, image := range allrecs {
 
@@ -21,6 +21,7 @@ func DataSourceIbmIsInstanceDisks() *schema.Resource {
 		ReadContext: dataSourceIbmIsInstanceDisksRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName),
 			"instance": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -79,6 +80,10 @@ func dataSourceIbmIsInstanceDisksRead(context context.Context, d *schema.Resourc
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	listInstanceDisksOptions := &vpcv1.ListInstanceDisksOptions{}
 
 	listInstanceDisksOptions.SetInstanceID(d.Get("instance").(string))
@@ -88,6 +93,12 @@ func dataSourceIbmIsInstanceDisksRead(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG] ListInstanceDisksWithContext failed %s\n%s", err, response)
 		return diag.FromErr(err)
 	}
+	instanceDiskCollection.Disks, err = filterIBMIsResources(meta, filters, instanceDiskCollection.Disks, func(disk vpcv1.InstanceDisk) isFilterAttributes {
+		return isFilterAttributes{Name: disk.Name}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(dataSourceIbmIsInstanceDisksID(d))
 
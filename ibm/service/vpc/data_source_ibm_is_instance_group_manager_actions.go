@@ -18,6 +18,8 @@ func DataSourceIBMISInstanceGroupManagerActions() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
+
 			"instance_group": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -156,6 +158,10 @@ func dataSourceIBMISInstanceGroupManagerActionsRead(d *schema.ResourceData, meta
 
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
 	instanceGroupID := d.Get("instance_group").(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 
 	// Support for pagination
 	start := ""
@@ -182,6 +188,13 @@ func dataSourceIBMISInstanceGroupManagerActionsRead(d *schema.ResourceData, meta
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(data vpcv1.InstanceGroupManagerActionIntf) isFilterAttributes {
+		instanceGroupManagerAction := data.(*vpcv1.InstanceGroupManagerAction)
+		return isFilterAttributes{Name: instanceGroupManagerAction.Name, LifecycleState: instanceGroupManagerAction.Status}
+	})
+	if err != nil {
+		return err
+	}
 
 	actions := make([]map[string]interface{}, 0)
 	for _, data := range allrecs {
@@ -18,6 +18,8 @@ func DataSourceIBMISInstanceGroupManagerPolicies() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			"filter": dataSourceIBMIsFilterSchema(isFilterName),
+
 			"instance_group": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -86,6 +88,10 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, met
 
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
 	instanceGroupID := d.Get("instance_group").(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 
 	// Support for pagination
 	start := ""
@@ -109,6 +115,12 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, met
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(data vpcv1.InstanceGroupManagerPolicyIntf) isFilterAttributes {
+		return isFilterAttributes{Name: data.(*vpcv1.InstanceGroupManagerPolicy).Name}
+	})
+	if err != nil {
+		return err
+	}
 
 	policies := make([]map[string]interface{}, 0)
 	for _, data := range allrecs {
@@ -18,6 +18,8 @@ func DataSourceIBMISInstanceGroupManagers() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			"filter": dataSourceIBMIsFilterSchema(isFilterName),
+
 			"instance_group": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -120,6 +122,10 @@ func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta inter
 	}
 
 	instanceGroupID := d.Get("instance_group").(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 
 	// Support for pagination
 	start := ""
@@ -145,6 +151,12 @@ func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta inter
 		}
 
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(instanceGroupManagerIntf vpcv1.InstanceGroupManagerIntf) isFilterAttributes {
+		return isFilterAttributes{Name: instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager).Name}
+	})
+	if err != nil {
+		return err
+	}
 
 	instanceGroupMnagers := make([]map[string]interface{}, 0)
 	for _, instanceGroupManagerIntf := range allrecs {
@@ -22,6 +22,7 @@ func DataSourceIBMISInstanceGroupMemberships() *schema.Resource {
 		Read: dataSourceIBMISInstanceGroupMembershipsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			isInstanceGroup: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -118,6 +119,10 @@ func dataSourceIBMISInstanceGroupMembershipsRead(d *schema.ResourceData, meta in
 		return err
 	}
 	instanceGroupID := d.Get(isInstanceGroup).(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	// Support for pagination
 	start := ""
 	allrecs := []vpcv1.InstanceGroupMembership{}
@@ -142,6 +147,12 @@ func dataSourceIBMISInstanceGroupMembershipsRead(d *schema.ResourceData, meta in
 		}
 
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(instanceGroupMembership vpcv1.InstanceGroupMembership) isFilterAttributes {
+		return isFilterAttributes{Name: instanceGroupMembership.Name, LifecycleState: instanceGroupMembership.Status}
+	})
+	if err != nil {
+		return err
+	}
 
 	memberships := make([]map[string]interface{}, 0)
 	for _, instanceGroupMembership := range allrecs {
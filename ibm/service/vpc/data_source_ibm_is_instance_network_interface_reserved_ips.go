@@ -28,6 +28,7 @@ func DataSourceIBMISInstanceNICReservedIPs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISInstanceNICReservedIPsRead,
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			/*
 				Request Parameters
 				==================
@@ -121,6 +122,10 @@ func dataSourceIBMISInstanceNICReservedIPsRead(context context.Context, d *schem
 
 	instanceID := d.Get(isInstanceID).(string)
 	nicID := d.Get(isInstanceNICID).(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Flatten all the reserved IPs
 	start := ""
@@ -144,6 +149,12 @@ func dataSourceIBMISInstanceNICReservedIPsRead(context context.Context, d *schem
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(data vpcv1.ReservedIP) isFilterAttributes {
+		return isFilterAttributes{Name: data.Name, LifecycleState: data.LifecycleState}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	// Now store all the reserved IP info with their response tags
 	reservedIPs := []map[string]interface{}{}
 	for _, data := range allrecs {
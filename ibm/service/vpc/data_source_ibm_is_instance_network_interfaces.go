@@ -22,6 +22,7 @@ func DataSourceIBMIsInstanceNetworkInterfaces() *schema.Resource {
 		ReadContext: dataSourceIBMIsInstanceNetworkInterfacesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			"instance_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -270,6 +271,10 @@ func dataSourceIBMIsInstanceNetworkInterfacesRead(context context.Context, d *sc
 	}
 
 	instance_name := d.Get("instance_name").(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	listInstancesOptions := &vpcv1.ListInstancesOptions{}
 
 	start := ""
@@ -304,6 +309,12 @@ func dataSourceIBMIsInstanceNetworkInterfacesRead(context context.Context, d *sc
 				log.Printf("[DEBUG] ListSecurityGroupNetworkInterfacesWithContext failed %s\n%s", err, response)
 				return diag.FromErr(fmt.Errorf("ListSecurityGroupNetworkInterfacesWithContext failed %s\n%s", err, response))
 			}
+			networkInterfaceCollection.NetworkInterfaces, err = filterIBMIsResources(meta, filters, networkInterfaceCollection.NetworkInterfaces, func(networkInterface vpcv1.NetworkInterface) isFilterAttributes {
+				return isFilterAttributes{Name: networkInterface.Name, LifecycleState: networkInterface.Status}
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
 
 			d.SetId(ins_id)
 
@@ -87,6 +87,7 @@ func DataSourceIBMISInstanceTemplates() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIBMISInstanceTemplatesRead,
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterResourceGroup, isFilterZone, isFilterVPC),
 			isInstanceTemplates: {
 				Type:        schema.TypeList,
 				Description: "Collection of instance templates",
@@ -378,13 +379,31 @@ func dataSourceIBMISInstanceTemplatesRead(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	listInstanceTemplatesOptions := &vpcv1.ListInstanceTemplatesOptions{}
 	availableTemplates, _, err := instanceC.ListInstanceTemplates(listInstanceTemplatesOptions)
 	if err != nil {
 		return err
 	}
+	allrecs, err := filterIBMIsResources(meta, filters, availableTemplates.Templates, func(instTempl vpcv1.InstanceTemplateIntf) isFilterAttributes {
+		instance := instTempl.(*vpcv1.InstanceTemplate)
+		attrs := isFilterAttributes{CRN: instance.CRN, Name: instance.Name, ResourceGroup: instance.ResourceGroup}
+		if vpc, ok := instance.VPC.(*vpcv1.VPCIdentity); ok {
+			attrs.VPC = &vpcv1.VPCReference{ID: vpc.ID}
+		}
+		if zone, ok := instance.Zone.(*vpcv1.ZoneIdentity); ok {
+			attrs.Zone = &vpcv1.ZoneReference{Name: zone.Name}
+		}
+		return attrs
+	})
+	if err != nil {
+		return err
+	}
 	templates := make([]map[string]interface{}, 0)
-	for _, instTempl := range availableTemplates.Templates {
+	for _, instTempl := range allrecs {
 		template := map[string]interface{}{}
 		instance := instTempl.(*vpcv1.InstanceTemplate)
 		template["id"] = instance.ID
@@ -16,6 +16,7 @@ func DataSourceIBMISInstanceVolumeAttachments() *schema.Resource {
 		Read: dataSourceIBMISInstanceVolumeAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			isInstanceId: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -124,6 +125,10 @@ func instanceGetVolumeAttachments(d *schema.ResourceData, meta interface{}, inst
 	if err != nil {
 		return err
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	allrecs := []vpcv1.VolumeAttachment{}
 	listInstanceVolumeAttOptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 		InstanceID: &instanceId,
@@ -133,6 +138,12 @@ func instanceGetVolumeAttachments(d *schema.ResourceData, meta interface{}, inst
 		return fmt.Errorf("[ERROR] Error Fetching Instance volume attachments %s\n%s", err, response)
 	}
 	allrecs = append(allrecs, volumeAtts.VolumeAttachments...)
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(volumeAtt vpcv1.VolumeAttachment) isFilterAttributes {
+		return isFilterAttributes{Name: volumeAtt.Name, LifecycleState: volumeAtt.Status}
+	})
+	if err != nil {
+		return err
+	}
 	volAttList := make([]map[string]interface{}, 0)
 	for _, volumeAtt := range allrecs {
 		currentVolAtt := map[string]interface{}{}
@@ -22,6 +22,7 @@ func DataSourceIBMISInstances() *schema.Resource {
 		Read: dataSourceIBMISInstancesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterVPC, isFilterLifecycleState),
 			isInstanceGroup: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -640,7 +641,18 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
+
 	listInstancesOptions := &vpcv1.ListInstancesOptions{}
+	if v, ok := filters.Value(isFilterVPC); ok && vpcID == "" {
+		vpcID = v
+	}
+	if v, ok := filters.Value(isFilterResourceGroup); ok && resourceGroup == "" {
+		resourceGroup = v
+	}
 
 	if vpcName != "" {
 		listInstancesOptions.VPCName = &vpcName
@@ -727,6 +739,13 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		allrecs = allrecs[:i]
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(instance vpcv1.Instance) isFilterAttributes {
+		return isFilterAttributes{CRN: instance.CRN, Name: instance.Name, ResourceGroup: instance.ResourceGroup, Zone: instance.Zone, VPC: instance.VPC, LifecycleState: instance.Status}
+	})
+	if err != nil {
+		return err
+	}
+
 	instancesInfo := make([]map[string]interface{}, 0)
 	for _, instance := range allrecs {
 		id := *instance.ID
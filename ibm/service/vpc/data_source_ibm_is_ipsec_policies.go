@@ -22,6 +22,7 @@ func DataSourceIBMIsIpsecPolicies() *schema.Resource {
 		ReadContext: dataSourceIBMIsIpsecPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterResourceGroup),
 			"ipsec_policies": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -162,6 +163,10 @@ func dataSourceIBMIsIpsecPoliciesRead(context context.Context, d *schema.Resourc
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	start := ""
 	allrecs := []vpcv1.IPsecPolicy{}
@@ -182,6 +187,13 @@ func dataSourceIBMIsIpsecPoliciesRead(context context.Context, d *schema.Resourc
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(policy vpcv1.IPsecPolicy) isFilterAttributes {
+		return isFilterAttributes{Name: policy.Name, ResourceGroup: policy.ResourceGroup}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(dataSourceIBMIsIpsecPoliciesID(d))
 
 	err = d.Set("ipsec_policies", dataSourceIPsecPolicyCollectionFlattenIpsecPolicies(allrecs))
@@ -49,3 +49,37 @@ func testAccCheckIBMIsIpsecPoliciesDataSourceConfigBasic(name string) string {
 		}
 	`, name)
 }
+
+func TestAccIBMIsIpsecPoliciesDataSourceFilter(t *testing.T) {
+	name := fmt.Sprintf("tfipsec-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsIpsecPoliciesDataSourceConfigFilter(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_ipsec_policies.is_ipsec_policies", "ipsec_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_ipsec_policies.is_ipsec_policies", "ipsec_policies.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsIpsecPoliciesDataSourceConfigFilter(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_ipsec_policy" "example" {
+			name = "%s"
+			authentication_algorithm = "sha1"
+			encryption_algorithm = "aes128"
+			pfs = "group_2"
+		}
+		data "ibm_is_ipsec_policies" "is_ipsec_policies" {
+			filter {
+				name   = "name"
+				values = ["^${ibm_is_ipsec_policy.example.name}$"]
+			}
+		}
+	`, name)
+}
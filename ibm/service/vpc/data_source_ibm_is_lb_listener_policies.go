@@ -20,6 +20,7 @@ func DataSourceIBMISLBListenerPolicies() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbListenerPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			isLBListenerPolicyLBID: &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -202,6 +203,11 @@ func dataSourceIBMIsLbListenerPoliciesRead(context context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	listLoadBalancerListenerPoliciesOptions := &vpcv1.ListLoadBalancerListenerPoliciesOptions{}
 
 	listLoadBalancerListenerPoliciesOptions.SetLoadBalancerID(d.Get(isLBListenerPolicyLBID).(string))
@@ -212,6 +218,12 @@ func dataSourceIBMIsLbListenerPoliciesRead(context context.Context, d *schema.Re
 		log.Printf("[DEBUG] ListLoadBalancerListenerPoliciesWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ListLoadBalancerListenerPoliciesWithContext failed %s\n%s", err, response))
 	}
+	loadBalancerListenerPolicyCollection.Policies, err = filterIBMIsResources(meta, filters, loadBalancerListenerPolicyCollection.Policies, func(policy vpcv1.LoadBalancerListenerPolicy) isFilterAttributes {
+		return isFilterAttributes{Name: policy.Name, LifecycleState: policy.ProvisioningStatus}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(dataSourceIBMIsLbListenerPoliciesID(d))
 
@@ -20,6 +20,8 @@ func DataSourceIBMISLBListenerPolicyRules() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbListenerPolicyRulesRead,
 
 		Schema: map[string]*schema.Schema{
+			// The rules have no name
+			"filter": dataSourceIBMIsFilterSchema(isFilterLifecycleState),
 			isLBListenerPolicyRuleLBID: &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -94,6 +96,11 @@ func dataSourceIBMIsLbListenerPolicyRulesRead(context context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	listLoadBalancerListenerPolicyRulesOptions := &vpcv1.ListLoadBalancerListenerPolicyRulesOptions{}
 	listLoadBalancerListenerPolicyRulesOptions.SetLoadBalancerID(d.Get(isLBListenerPolicyRuleLBID).(string))
 	listLoadBalancerListenerPolicyRulesOptions.SetListenerID(d.Get(isLBListenerPolicyRuleListenerID).(string))
@@ -104,6 +111,12 @@ func dataSourceIBMIsLbListenerPolicyRulesRead(context context.Context, d *schema
 		log.Printf("[DEBUG] ListLoadBalancerListenerPolicyRulesWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ListLoadBalancerListenerPolicyRulesWithContext failed %s\n%s", err, response))
 	}
+	loadBalancerListenerPolicyRuleCollection.Rules, err = filterIBMIsResources(meta, filters, loadBalancerListenerPolicyRuleCollection.Rules, func(rule vpcv1.LoadBalancerListenerPolicyRule) isFilterAttributes {
+		return isFilterAttributes{LifecycleState: rule.ProvisioningStatus}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(dataSourceIBMIsLbListenerPolicyRulesID(d))
 
@@ -20,6 +20,8 @@ func DataSourceIBMISLBListeners() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbListenersRead,
 
 		Schema: map[string]*schema.Schema{
+			// The listeners have no name
+			"filter": dataSourceIBMIsFilterSchema(isFilterLifecycleState),
 			isLBListenerLBID: &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -231,6 +233,11 @@ func dataSourceIBMIsLbListenersRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	listLoadBalancerListenersOptions := &vpcv1.ListLoadBalancerListenersOptions{}
 
 	listLoadBalancerListenersOptions.SetLoadBalancerID(d.Get(isLBListenerLBID).(string))
@@ -240,6 +247,12 @@ func dataSourceIBMIsLbListenersRead(context context.Context, d *schema.ResourceD
 		log.Printf("[DEBUG] ListLoadBalancerListenersWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ListLoadBalancerListenersWithContext failed %s\n%s", err, response))
 	}
+	loadBalancerListenerCollection.Listeners, err = filterIBMIsResources(meta, filters, loadBalancerListenerCollection.Listeners, func(listener vpcv1.LoadBalancerListener) isFilterAttributes {
+		return isFilterAttributes{LifecycleState: listener.ProvisioningStatus}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(dataSourceIBMIsLbListenersID(d))
 
@@ -20,6 +20,8 @@ func DataSourceIBMISLBPoolMembers() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbPoolMembersRead,
 
 		Schema: map[string]*schema.Schema{
+			// The members have no name
+			"filter": dataSourceIBMIsFilterSchema(isFilterLifecycleState),
 			"lb": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -132,6 +134,11 @@ func dataSourceIBMIsLbPoolMembersRead(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{}
 
 	listLoadBalancerPoolMembersOptions.SetLoadBalancerID(d.Get("lb").(string))
@@ -142,6 +149,12 @@ func dataSourceIBMIsLbPoolMembersRead(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG] ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response))
 	}
+	loadBalancerPoolMemberCollection.Members, err = filterIBMIsResources(meta, filters, loadBalancerPoolMemberCollection.Members, func(member vpcv1.LoadBalancerPoolMember) isFilterAttributes {
+		return isFilterAttributes{LifecycleState: member.ProvisioningStatus}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(dataSourceIBMIsLbPoolMembersID(d))
 
@@ -20,6 +20,7 @@ func DataSourceIBMISLBPools() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbPoolsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			"lb": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -218,6 +219,11 @@ func dataSourceIBMIsLbPoolsRead(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	listLoadBalancerPoolsOptions := &vpcv1.ListLoadBalancerPoolsOptions{}
 
 	listLoadBalancerPoolsOptions.SetLoadBalancerID(d.Get("lb").(string))
@@ -227,6 +233,12 @@ func dataSourceIBMIsLbPoolsRead(context context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG] ListLoadBalancerPoolsWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolsWithContext failed %s\n%s", err, response))
 	}
+	loadBalancerPoolCollection.Pools, err = filterIBMIsResources(meta, filters, loadBalancerPoolCollection.Pools, func(pool vpcv1.LoadBalancerPool) isFilterAttributes {
+		return isFilterAttributes{Name: pool.Name, LifecycleState: pool.ProvisioningStatus}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if err = d.Set("lb", d.Get("lb").(string)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting lb: %s", err))
 	}
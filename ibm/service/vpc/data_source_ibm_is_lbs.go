@@ -26,6 +26,7 @@ func DataSourceIBMISLBS() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIBMISLBSRead,
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterLifecycleState),
 			loadBalancers: {
 				Type:        schema.TypeList,
 				Description: "Collection of load balancers",
@@ -270,6 +271,10 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	start := ""
 	allrecs := []vpcv1.LoadBalancer{}
 	for {
@@ -288,6 +293,13 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(lb vpcv1.LoadBalancer) isFilterAttributes {
+		return isFilterAttributes{CRN: lb.CRN, Name: lb.Name, ResourceGroup: lb.ResourceGroup, LifecycleState: lb.ProvisioningStatus}
+	})
+	if err != nil {
+		return err
+	}
+
 	lbList := make([]map[string]interface{}, 0)
 
 	for _, lb := range allrecs {
@@ -0,0 +1,501 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// The kinds of findings of the network ACL analysis.
+const (
+	isNetworkACLAnalysisContradictory = "contradictory"
+	isNetworkACLAnalysisRedundant     = "redundant"
+	isNetworkACLAnalysisShadowed      = "shadowed"
+)
+
+func DataSourceIBMIsNetworkACLAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsNetworkACLAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_acl": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the network ACL to analyze.",
+			},
+			"findings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules which are shadowed, redundant or contradictory.",
+				Elem:        isNetworkACLAnalysisFindingResource(),
+			},
+			"reachable": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The traffic allowed by the rules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination IP address or CIDR block of the allowed traffic.",
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The direction of the allowed traffic.",
+						},
+						"partially_denied_by": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The earlier deny rules which deny a part of the traffic the port ranges don't account for.",
+						},
+						"port_ranges": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The allowed destination port ranges of the tcp and udp rules.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol of the allowed traffic.",
+						},
+						"rule": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule which allows the traffic.",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The source IP address or CIDR block of the allowed traffic.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// isNetworkACLAnalysisFindingResource is the schema of the findings of the
+// data source and of the rule_analysis of the ibm_is_network_acl resource.
+func isNetworkACLAnalysisFindingResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"conflicting_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the earlier rules the rule conflicts with.",
+			},
+			"direction": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The direction of the rule.",
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The kind of the finding, shadowed, redundant or contradictory.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the finding.",
+			},
+			"rule": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the rule.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsNetworkACLAnalysisRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nwaclID := d.Get("network_acl").(string)
+	listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+		NetworkACLID: &nwaclID,
+	}
+	start := ""
+	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
+	for {
+		if start != "" {
+			listNetworkACLRulesOptions.Start = &start
+		}
+		ruleCollection, response, err := vpcClient.ListNetworkACLRulesWithContext(context, listNetworkACLRulesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListNetworkACLRulesWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListNetworkACLRules", err, response)
+		}
+		start = flex.GetNext(ruleCollection.Next)
+		allrecs = append(allrecs, ruleCollection.Rules...)
+		if start == "" {
+			break
+		}
+	}
+
+	rules := make([]*isNetworkACLAnalysisRule, 0, len(allrecs))
+	for _, item := range allrecs {
+		rule, err := isNetworkACLAnalysisRuleFromItem(item)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules = append(rules, rule)
+	}
+	findings, reachable := isNetworkACLAnalyze(rules)
+
+	d.SetId(nwaclID)
+	if err = d.Set("findings", isNetworkACLAnalysisFlattenFindings(findings)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting findings %s", err))
+	}
+	reachableList := make([]map[string]interface{}, 0, len(reachable))
+	for _, r := range reachable {
+		reachableList = append(reachableList, map[string]interface{}{
+			"destination":         r.rule.destination,
+			"direction":           r.rule.direction,
+			"partially_denied_by": r.partiallyDeniedBy,
+			"port_ranges":         r.portRanges,
+			"protocol":            r.rule.protocol,
+			"rule":                r.rule.name,
+			"source":              r.rule.source,
+		})
+	}
+	if err = d.Set("reachable", reachableList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting reachable %s", err))
+	}
+	return nil
+}
+
+func isNetworkACLAnalysisFlattenFindings(findings []isNetworkACLAnalysisFinding) []map[string]interface{} {
+	findingList := make([]map[string]interface{}, 0, len(findings))
+	for _, finding := range findings {
+		findingList = append(findingList, map[string]interface{}{
+			"conflicting_rules": finding.conflictingRules,
+			"direction":         finding.rule.direction,
+			"kind":              finding.kind,
+			"message":           finding.message,
+			"rule":              finding.rule.name,
+		})
+	}
+	return findingList
+}
+
+// isNetworkACLAnalysisRange is an inclusive range of addresses, ports, ICMP
+// types or ICMP codes.
+type isNetworkACLAnalysisRange struct {
+	min, max int64
+}
+
+func (r isNetworkACLAnalysisRange) covers(other isNetworkACLAnalysisRange) bool {
+	return r.min <= other.min && other.max <= r.max
+}
+
+func (r isNetworkACLAnalysisRange) overlaps(other isNetworkACLAnalysisRange) bool {
+	return r.min <= other.max && other.min <= r.max
+}
+
+func (r isNetworkACLAnalysisRange) String() string {
+	if r.min == r.max {
+		return fmt.Sprint(r.min)
+	}
+	return fmt.Sprintf("%d-%d", r.min, r.max)
+}
+
+// isNetworkACLAnalysisRule is a network ACL rule as the set of the packets it
+// matches.
+type isNetworkACLAnalysisRule struct {
+	name        string
+	action      string
+	direction   string
+	protocol    string
+	source      string
+	destination string
+
+	sourceRange      isNetworkACLAnalysisRange
+	destinationRange isNetworkACLAnalysisRange
+	// protocolRanges are the destination and source port ranges of the tcp
+	// and udp rules, and the type and code ranges of the icmp rules.
+	protocolRanges [2]isNetworkACLAnalysisRange
+}
+
+func newIsNetworkACLAnalysisRule(name, action, direction, protocol, source, destination string) (*isNetworkACLAnalysisRule, error) {
+	rule := &isNetworkACLAnalysisRule{
+		name:        name,
+		action:      action,
+		direction:   direction,
+		protocol:    protocol,
+		source:      source,
+		destination: destination,
+	}
+	var err error
+	if rule.sourceRange, err = isNetworkACLAnalysisAddressRange(source); err != nil {
+		return nil, err
+	}
+	if rule.destinationRange, err = isNetworkACLAnalysisAddressRange(destination); err != nil {
+		return nil, err
+	}
+	switch protocol {
+	case "tcp", "udp":
+		rule.protocolRanges = [2]isNetworkACLAnalysisRange{{1, 65535}, {1, 65535}}
+	case "icmp":
+		rule.protocolRanges = [2]isNetworkACLAnalysisRange{{0, 255}, {0, 255}}
+	}
+	return rule, nil
+}
+
+// isNetworkACLAnalysisAddressRange returns the range of the IPv4 addresses of
+// an address or CIDR block.
+func isNetworkACLAnalysisAddressRange(address string) (isNetworkACLAnalysisRange, error) {
+	cidr := address
+	if !strings.Contains(cidr, "/") {
+		cidr += "/32"
+	}
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil || ipNet.IP.To4() == nil {
+		return isNetworkACLAnalysisRange{}, fmt.Errorf("[ERROR] Error analyzing the network ACL rules, %q isn't an IPv4 address or CIDR block", address)
+	}
+	ones, _ := ipNet.Mask.Size()
+	min := int64(binary.BigEndian.Uint32(ipNet.IP.To4()))
+	return isNetworkACLAnalysisRange{min, min + 1<<(32-ones) - 1}, nil
+}
+
+func setIsNetworkACLAnalysisRange(r *isNetworkACLAnalysisRange, min, max *int64) {
+	if min != nil {
+		r.min = *min
+	}
+	if max != nil {
+		r.max = *max
+	}
+}
+
+// isNetworkACLAnalysisRuleFromItem returns the analysis rule of a rule of the API.
+func isNetworkACLAnalysisRuleFromItem(item vpcv1.NetworkACLRuleItemIntf) (*isNetworkACLAnalysisRule, error) {
+	switch rulex := item.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		return newIsNetworkACLAnalysisRule(*rulex.Name, *rulex.Action, *rulex.Direction, *rulex.Protocol, *rulex.Source, *rulex.Destination)
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		rule, err := newIsNetworkACLAnalysisRule(*rulex.Name, *rulex.Action, *rulex.Direction, *rulex.Protocol, *rulex.Source, *rulex.Destination)
+		if err != nil {
+			return nil, err
+		}
+		setIsNetworkACLAnalysisRange(&rule.protocolRanges[0], rulex.Type, rulex.Type)
+		setIsNetworkACLAnalysisRange(&rule.protocolRanges[1], rulex.Code, rulex.Code)
+		return rule, nil
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		rule, err := newIsNetworkACLAnalysisRule(*rulex.Name, *rulex.Action, *rulex.Direction, *rulex.Protocol, *rulex.Source, *rulex.Destination)
+		if err != nil {
+			return nil, err
+		}
+		setIsNetworkACLAnalysisRange(&rule.protocolRanges[0], rulex.DestinationPortMin, rulex.DestinationPortMax)
+		setIsNetworkACLAnalysisRange(&rule.protocolRanges[1], rulex.SourcePortMin, rulex.SourcePortMax)
+		return rule, nil
+	}
+	return nil, fmt.Errorf("[ERROR] Error analyzing the network ACL rules, unexpected rule type %T", item)
+}
+
+// isNetworkACLAnalysisRulesFromList returns the analysis rules of the rules
+// argument of the ibm_is_network_acl resource.
+func isNetworkACLAnalysisRulesFromList(rules []interface{}) ([]*isNetworkACLAnalysisRule, error) {
+	analysisRules := make([]*isNetworkACLAnalysisRule, 0, len(rules))
+	for _, ruleIntf := range rules {
+		rulex := ruleIntf.(map[string]interface{})
+		protocol := "all"
+		var protocolBlock []interface{}
+		for _, p := range []string{isNetworkACLRuleICMP, isNetworkACLRuleTCP, isNetworkACLRuleUDP} {
+			if block, ok := rulex[p].([]interface{}); ok && len(block) > 0 {
+				protocol, protocolBlock = p, block
+			}
+		}
+		rule, err := newIsNetworkACLAnalysisRule(rulex[isNetworkACLRuleName].(string), rulex[isNetworkACLRuleAction].(string),
+			strings.ToLower(rulex[isNetworkACLRuleDirection].(string)), protocol, rulex[isNetworkACLRuleSource].(string), rulex[isNetworkACLRuleDestination].(string))
+		if err != nil {
+			return nil, err
+		}
+		if protocol == "all" {
+			analysisRules = append(analysisRules, rule)
+			continue
+		}
+		if block, ok := protocolBlock[0].(map[string]interface{}); ok {
+			keys := [2][2]string{{isNetworkACLRulePortMin, isNetworkACLRulePortMax}, {isNetworkACLRuleSourcePortMin, isNetworkACLRuleSourcePortMax}}
+			if protocol == isNetworkACLRuleICMP {
+				keys = [2][2]string{{isNetworkACLRuleICMPType, isNetworkACLRuleICMPType}, {isNetworkACLRuleICMPCode, isNetworkACLRuleICMPCode}}
+			}
+			for i, k := range keys {
+				min, minOk := block[k[0]].(int)
+				max, maxOk := block[k[1]].(int)
+				if minOk && maxOk {
+					rule.protocolRanges[i] = isNetworkACLAnalysisRange{int64(min), int64(max)}
+				}
+			}
+		}
+		analysisRules = append(analysisRules, rule)
+	}
+	return analysisRules, nil
+}
+
+// covers returns whether the rule matches all the packets the other rule matches.
+func (rule *isNetworkACLAnalysisRule) covers(other *isNetworkACLAnalysisRule) bool {
+	if !rule.sourceRange.covers(other.sourceRange) || !rule.destinationRange.covers(other.destinationRange) {
+		return false
+	}
+	if rule.protocol == "all" {
+		return true
+	}
+	return rule.protocol == other.protocol && rule.protocolRanges[0].covers(other.protocolRanges[0]) && rule.protocolRanges[1].covers(other.protocolRanges[1])
+}
+
+// overlaps returns whether the rules both match some packets.
+func (rule *isNetworkACLAnalysisRule) overlaps(other *isNetworkACLAnalysisRule) bool {
+	if !rule.sourceRange.overlaps(other.sourceRange) || !rule.destinationRange.overlaps(other.destinationRange) {
+		return false
+	}
+	if rule.protocol == "all" || other.protocol == "all" {
+		return true
+	}
+	return rule.protocol == other.protocol && rule.protocolRanges[0].overlaps(other.protocolRanges[0]) && rule.protocolRanges[1].overlaps(other.protocolRanges[1])
+}
+
+type isNetworkACLAnalysisFinding struct {
+	rule             *isNetworkACLAnalysisRule
+	kind             string
+	conflictingRules []string
+	message          string
+}
+
+type isNetworkACLAnalysisReachable struct {
+	rule              *isNetworkACLAnalysisRule
+	portRanges        []string
+	partiallyDeniedBy []string
+}
+
+func isNetworkACLAnalysisActioned(action string) string {
+	if action == "deny" {
+		return "denied"
+	}
+	return "allowed"
+}
+
+// isNetworkACLAnalyze evaluates the ordered rules of a network ACL. A rule
+// is shadowed or redundant when an earlier rule of the same direction matches
+// all its packets with another or the same action. A rule is contradictory
+// when an earlier rule with another action matches a part of its packets, and
+// the rule isn't the broader one of the two. The port ranges of the reachable
+// traffic of the allow rules exclude the ports of the earlier deny rules which
+// match the rest of the allowed packets.
+func isNetworkACLAnalyze(rules []*isNetworkACLAnalysisRule) ([]isNetworkACLAnalysisFinding, []isNetworkACLAnalysisReachable) {
+	findings := []isNetworkACLAnalysisFinding{}
+	reachable := []isNetworkACLAnalysisReachable{}
+	for i, rule := range rules {
+		var earlierRules []*isNetworkACLAnalysisRule
+		for _, earlier := range rules[:i] {
+			if earlier.direction == rule.direction {
+				earlierRules = append(earlierRules, earlier)
+			}
+		}
+
+		var finding *isNetworkACLAnalysisFinding
+		for _, earlier := range earlierRules {
+			if !earlier.covers(rule) {
+				continue
+			}
+			if earlier.action == rule.action {
+				finding = &isNetworkACLAnalysisFinding{rule, isNetworkACLAnalysisRedundant, []string{earlier.name},
+					fmt.Sprintf("The rule %s is redundant, all its traffic is already %s by the earlier rule %s.", rule.name, isNetworkACLAnalysisActioned(earlier.action), earlier.name)}
+			} else {
+				finding = &isNetworkACLAnalysisFinding{rule, isNetworkACLAnalysisShadowed, []string{earlier.name},
+					fmt.Sprintf("The rule %s never matches, all its traffic is %s by the earlier rule %s.", rule.name, isNetworkACLAnalysisActioned(earlier.action), earlier.name)}
+			}
+			break
+		}
+		if finding != nil {
+			findings = append(findings, *finding)
+			continue
+		}
+
+		var contradicting []string
+		for _, earlier := range earlierRules {
+			if earlier.action != rule.action && earlier.overlaps(rule) && !rule.covers(earlier) {
+				contradicting = append(contradicting, earlier.name)
+			}
+		}
+		if len(contradicting) > 0 {
+			actioned := isNetworkACLAnalysisActioned("deny")
+			if rule.action == "deny" {
+				actioned = isNetworkACLAnalysisActioned("allow")
+			}
+			findings = append(findings, isNetworkACLAnalysisFinding{rule, isNetworkACLAnalysisContradictory, contradicting,
+				fmt.Sprintf("A part of the traffic of the rule %s is %s by the earlier rules %s.", rule.name, actioned, strings.Join(contradicting, ", "))})
+		}
+
+		if rule.action != "allow" {
+			continue
+		}
+		allowed := isNetworkACLAnalysisReachable{rule: rule, portRanges: []string{}, partiallyDeniedBy: []string{}}
+		var ports []isNetworkACLAnalysisRange
+		var denying []string
+		if rule.protocol == "tcp" || rule.protocol == "udp" {
+			ports = []isNetworkACLAnalysisRange{rule.protocolRanges[0]}
+		}
+		for _, earlier := range earlierRules {
+			if earlier.action != "deny" || !earlier.overlaps(rule) {
+				continue
+			}
+			if ports != nil && earlier.protocol == rule.protocol && earlier.sourceRange.covers(rule.sourceRange) &&
+				earlier.destinationRange.covers(rule.destinationRange) && earlier.protocolRanges[1].covers(rule.protocolRanges[1]) {
+				ports = isNetworkACLAnalysisSubtract(ports, earlier.protocolRanges[0])
+				denying = append(denying, earlier.name)
+				continue
+			}
+			allowed.partiallyDeniedBy = append(allowed.partiallyDeniedBy, earlier.name)
+		}
+		if ports != nil && len(ports) == 0 {
+			findings = append(findings, isNetworkACLAnalysisFinding{rule, isNetworkACLAnalysisShadowed, denying,
+				fmt.Sprintf("The rule %s never matches, all its ports are denied by the earlier rules %s.", rule.name, strings.Join(denying, ", "))})
+			continue
+		}
+		for _, r := range ports {
+			allowed.portRanges = append(allowed.portRanges, r.String())
+		}
+		reachable = append(reachable, allowed)
+	}
+	return findings, reachable
+}
+
+// isNetworkACLAnalysisSubtract removes a range from sorted disjoint ranges.
+func isNetworkACLAnalysisSubtract(ranges []isNetworkACLAnalysisRange, removed isNetworkACLAnalysisRange) []isNetworkACLAnalysisRange {
+	result := []isNetworkACLAnalysisRange{}
+	for _, r := range ranges {
+		if !r.overlaps(removed) {
+			result = append(result, r)
+			continue
+		}
+		if r.min < removed.min {
+			result = append(result, isNetworkACLAnalysisRange{r.min, removed.min - 1})
+		}
+		if removed.max < r.max {
+			result = append(result, isNetworkACLAnalysisRange{removed.max + 1, r.max})
+		}
+	}
+	return result
+}
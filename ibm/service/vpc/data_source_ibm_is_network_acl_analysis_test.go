@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/fakevpc"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISNetworkACLAnalysisDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-nwacl-analysis-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-nwacl-analysis-%d", acctest.RandIntRange(10, 100))
+	node := "data.ibm_is_network_acl_analysis.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLAnalysisDataSourceConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_network_acl.test", "rule_analysis.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_network_acl.test", "rule_analysis.0.kind", "shadowed"),
+					resource.TestCheckResourceAttr(node, "findings.#", "1"),
+					resource.TestCheckResourceAttr(node, "findings.0.rule", "deny-ssh"),
+					resource.TestCheckResourceAttr(node, "findings.0.kind", "shadowed"),
+					resource.TestCheckResourceAttr(node, "findings.0.conflicting_rules.0", "allow-ssh"),
+					resource.TestCheckResourceAttr(node, "reachable.#", "2"),
+					resource.TestCheckResourceAttr(node, "reachable.0.port_ranges.0", "22"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISNetworkACLAnalysisDataSourceConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name = "%s"
+	}
+
+	resource "ibm_is_network_acl" "test" {
+		name = "%s"
+		vpc  = ibm_is_vpc.test.id
+		rules {
+			name        = "allow-ssh"
+			action      = "allow"
+			source      = "10.0.0.0/8"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+		rules {
+			name        = "deny-ssh"
+			action      = "deny"
+			source      = "10.1.0.0/16"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+		rules {
+			name        = "allow-outbound"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "outbound"
+		}
+	}
+
+	data "ibm_is_network_acl_analysis" "test" {
+		network_acl = ibm_is_network_acl.test.id
+	}`, vpcname, name)
+}
+
+func TestIBMISNetworkACLAnalysis_fakeAPI(t *testing.T) {
+	t.Parallel()
+	server := fakevpc.NewServer()
+	defer server.Close()
+	meta := server.ClientSession()
+
+	sess, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcResult, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("tf-fake-vpc")})
+	if err != nil {
+		t.Fatalf("Error creating the VPC: %s", err)
+	}
+	aclID := *vpcResult.DefaultNetworkACL.ID
+	for _, rule := range []struct {
+		name, action, direction, protocol, source string
+		portMin, portMax                          int64
+	}{
+		{"allow-ssh", "allow", "inbound", "tcp", "10.0.0.0/8", 22, 22},
+		{"deny-ssh-subnet", "deny", "inbound", "tcp", "10.1.0.0/16", 22, 22},
+		{"allow-ssh-again", "allow", "inbound", "tcp", "10.2.3.4", 22, 22},
+		{"deny-web", "deny", "inbound", "tcp", "0.0.0.0/0", 80, 443},
+		{"allow-low-ports", "allow", "inbound", "tcp", "192.168.0.0/16", 1, 1024},
+		{"deny-all", "deny", "inbound", "all", "0.0.0.0/0", 0, 0},
+		{"allow-outbound", "allow", "outbound", "all", "0.0.0.0/0", 0, 0},
+	} {
+		prototype := &vpcv1.NetworkACLRulePrototype{
+			Name:        core.StringPtr(rule.name),
+			Action:      core.StringPtr(rule.action),
+			Direction:   core.StringPtr(rule.direction),
+			Protocol:    core.StringPtr(rule.protocol),
+			Source:      core.StringPtr(rule.source),
+			Destination: core.StringPtr("0.0.0.0/0"),
+		}
+		if rule.protocol == "tcp" {
+			prototype.DestinationPortMin = core.Int64Ptr(rule.portMin)
+			prototype.DestinationPortMax = core.Int64Ptr(rule.portMax)
+		}
+		_, _, err := sess.CreateNetworkACLRule(&vpcv1.CreateNetworkACLRuleOptions{
+			NetworkACLID:            &aclID,
+			NetworkACLRulePrototype: prototype,
+		})
+		if err != nil {
+			t.Fatalf("Error creating the rule %s: %s", rule.name, err)
+		}
+	}
+
+	r := vpc.DataSourceIBMIsNetworkACLAnalysis()
+	d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"network_acl": aclID})
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Error reading the analysis: %v", diags)
+	}
+
+	findings := map[string]string{}
+	conflicting := map[string][]string{}
+	for _, findingIntf := range d.Get("findings").([]interface{}) {
+		finding := findingIntf.(map[string]interface{})
+		findings[finding["rule"].(string)] = finding["kind"].(string)
+		conflicting[finding["rule"].(string)] = flex.ExpandStringList(finding["conflicting_rules"].([]interface{}))
+	}
+	expectedFindings := map[string]string{
+		"deny-ssh-subnet": "shadowed",
+		"allow-ssh-again": "redundant",
+		"allow-low-ports": "contradictory",
+	}
+	if !reflect.DeepEqual(findings, expectedFindings) {
+		t.Errorf("Expected the findings %v, got %v", expectedFindings, findings)
+	}
+	if c := conflicting["deny-ssh-subnet"]; len(c) != 1 || c[0] != "allow-ssh" {
+		t.Errorf("Expected deny-ssh-subnet to conflict with allow-ssh, got %v", c)
+	}
+	if c := conflicting["allow-low-ports"]; len(c) != 1 || c[0] != "deny-web" {
+		t.Errorf("Expected allow-low-ports to conflict with deny-web, got %v", c)
+	}
+
+	reachable := map[string][]string{}
+	for _, reachableIntf := range d.Get("reachable").([]interface{}) {
+		allowed := reachableIntf.(map[string]interface{})
+		reachable[allowed["rule"].(string)] = flex.ExpandStringList(allowed["port_ranges"].([]interface{}))
+	}
+	expectedReachable := map[string][]string{
+		"allow-ssh":       {"22"},
+		"allow-low-ports": {"1-79", "444-1024"},
+		"allow-outbound":  {},
+	}
+	if !reflect.DeepEqual(reachable, expectedReachable) {
+		t.Errorf("Expected the reachable port ranges %v, got %v", expectedReachable, reachable)
+	}
+}
@@ -22,6 +22,7 @@ func DataSourceIBMISNetworkACLRules() *schema.Resource {
 		Read: dataSourceIBMISNetworkACLRulesRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName),
 			isNwACLID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -183,6 +184,10 @@ func networkACLRulesList(d *schema.ResourceData, meta interface{}, nwACLID strin
 	if err != nil {
 		return err
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	start := ""
 	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
 	for {
@@ -204,6 +209,20 @@ func networkACLRulesList(d *schema.ResourceData, meta interface{}, nwACLID strin
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(rule vpcv1.NetworkACLRuleItemIntf) isFilterAttributes {
+		switch rulex := rule.(type) {
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+			return isFilterAttributes{Name: rulex.Name}
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+			return isFilterAttributes{Name: rulex.Name}
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+			return isFilterAttributes{Name: rulex.Name}
+		}
+		return isFilterAttributes{}
+	})
+	if err != nil {
+		return err
+	}
 	rulesInfo := make([]map[string]interface{}, 0)
 	for _, rule := range allrecs {
 		l := map[string]interface{}{}
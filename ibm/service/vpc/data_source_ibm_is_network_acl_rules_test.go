@@ -6,9 +6,15 @@ package vpc_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/fakevpc"
 
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -49,3 +55,77 @@ func testAccCheckIBMISNetworkACLRulesDataSourceConfig() string {
 			network_acl = ibm_is_vpc.testacc_nacl_vpc.default_network_acl
 		}`)
 }
+
+func TestIBMISNetworkACLRules_fakeAPIFilter(t *testing.T) {
+	t.Parallel()
+	server := fakevpc.NewServer()
+	defer server.Close()
+	meta := server.ClientSession()
+
+	sess, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcResult, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("tf-fake-vpc")})
+	if err != nil {
+		t.Fatalf("Error creating the VPC: %s", err)
+	}
+	aclID := *vpcResult.DefaultNetworkACL.ID
+	for _, name := range []string{"tf-fake-allow-ssh", "tf-fake-deny-all", "other-rule"} {
+		_, _, err := sess.CreateNetworkACLRule(&vpcv1.CreateNetworkACLRuleOptions{
+			NetworkACLID: &aclID,
+			NetworkACLRulePrototype: &vpcv1.NetworkACLRulePrototype{
+				Name:        core.StringPtr(name),
+				Action:      core.StringPtr("allow"),
+				Direction:   core.StringPtr("inbound"),
+				Protocol:    core.StringPtr("all"),
+				Source:      core.StringPtr("0.0.0.0/0"),
+				Destination: core.StringPtr("0.0.0.0/0"),
+			},
+		})
+		if err != nil {
+			t.Fatalf("Error creating the rule %s: %s", name, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		filters []interface{}
+		want    []string
+	}{
+		{
+			name:    "name",
+			filters: []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"^tf-fake-"}}},
+			want:    []string{"tf-fake-allow-ssh", "tf-fake-deny-all"},
+		},
+		{
+			name:    "several values",
+			filters: []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"ssh$", "^other-"}}},
+			want:    []string{"tf-fake-allow-ssh", "other-rule"},
+		},
+		{
+			name:    "no match",
+			filters: []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"^none$"}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := vpc.DataSourceIBMISNetworkACLRules()
+			d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"network_acl": aclID, "filter": tc.filters})
+			if err := r.Read(d, meta); err != nil {
+				t.Fatalf("Error reading the rules: %s", err)
+			}
+			got := map[string]bool{}
+			for _, rule := range d.Get("rules").([]interface{}) {
+				got[rule.(map[string]interface{})["name"].(string)] = true
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("Expected the rules %v, got %v", tc.want, got)
+			}
+			for _, name := range tc.want {
+				if !got[name] {
+					t.Errorf("Expected the rule %s to be listed, got %v", name, got)
+				}
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ func DataSourceIBMIsNetworkAcls() *schema.Resource {
 		ReadContext: dataSourceIBMIsNetworkAclsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterVPC),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -357,6 +358,13 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 	resource_group_id := d.Get("resource_group").(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := filters.Value(isFilterResourceGroup); ok && resource_group_id == "" {
+		resource_group_id = v
+	}
 	start := ""
 	allrecs := []vpcv1.NetworkACL{}
 	listNetworkAclsOptions := &vpcv1.ListNetworkAclsOptions{}
@@ -379,6 +387,13 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(networkACL vpcv1.NetworkACL) isFilterAttributes {
+		return isFilterAttributes{CRN: networkACL.CRN, Name: networkACL.Name, ResourceGroup: networkACL.ResourceGroup, VPC: networkACL.VPC}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(dataSourceIBMIsNetworkAclsID(d))
 
 	err = d.Set("network_acls", dataSourceNetworkACLCollectionFlattenNetworkAcls(allrecs))
@@ -22,6 +22,7 @@ func DataSourceIbmIsPlacementGroups() *schema.Resource {
 		ReadContext: dataSourceIbmIsPlacementGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterLifecycleState),
 			"placement_groups": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -124,6 +125,10 @@ func dataSourceIbmIsPlacementGroupsRead(context context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	listPlacementGroupsOptions := &vpcv1.ListPlacementGroupsOptions{}
 	start := ""
 	allrecs := []vpcv1.PlacementGroup{}
@@ -143,6 +148,13 @@ func dataSourceIbmIsPlacementGroupsRead(context context.Context, d *schema.Resou
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(placementGroup vpcv1.PlacementGroup) isFilterAttributes {
+		return isFilterAttributes{CRN: placementGroup.CRN, Name: placementGroup.Name, ResourceGroup: placementGroup.ResourceGroup, LifecycleState: placementGroup.LifecycleState}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(dataSourceIbmIsPlacementGroupsID(d))
 	if len(allrecs) > 0 {
 		err = d.Set("placement_groups", dataSourcePlacementGroupCollectionFlattenPlacementGroups(meta, allrecs))
@@ -22,6 +22,7 @@ func DataSourceIBMISPublicGateways() *schema.Resource {
 		Read: dataSourceIBMISPublicGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterVPC, isFilterLifecycleState),
 			isPublicGateways: {
 				Type:        schema.TypeList,
 				Description: "List of public gateways",
@@ -137,6 +138,13 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 	if rg, ok := d.GetOk(isPublicGatewayResourceGroup); ok {
 		rgroup = rg.(string)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
+	if v, ok := filters.Value(isFilterResourceGroup); ok && rgroup == "" {
+		rgroup = v
+	}
 	start := ""
 	allrecs := []vpcv1.PublicGateway{}
 	for {
@@ -157,6 +165,13 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 			break
 		}
 	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(publicgw vpcv1.PublicGateway) isFilterAttributes {
+		return isFilterAttributes{CRN: publicgw.CRN, Name: publicgw.Name, ResourceGroup: publicgw.ResourceGroup, Zone: publicgw.Zone, VPC: publicgw.VPC, LifecycleState: publicgw.Status}
+	})
+	if err != nil {
+		return err
+	}
 	publicgwInfo := make([]map[string]interface{}, 0)
 	for _, publicgw := range allrecs {
 		id := *publicgw.ID
@@ -18,6 +18,8 @@ func DataSourceIBMISSecurityGroupTargets() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			"filter": dataSourceIBMIsFilterSchema(isFilterName),
+
 			"security_group": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -75,6 +77,10 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 	}
 
 	securityGroupID := d.Get("security_group").(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 
 	// Support for pagination
 	start := ""
@@ -101,6 +107,13 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 		}
 
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(securityGroupTargetReferenceIntf vpcv1.SecurityGroupTargetReferenceIntf) isFilterAttributes {
+		securityGroupTargetReference := securityGroupTargetReferenceIntf.(*vpcv1.SecurityGroupTargetReference)
+		return isFilterAttributes{CRN: securityGroupTargetReference.CRN, Name: securityGroupTargetReference.Name}
+	})
+	if err != nil {
+		return err
+	}
 
 	targets := make([]map[string]interface{}, 0)
 	for _, securityGroupTargetReferenceIntf := range allrecs {
@@ -22,6 +22,7 @@ func DataSourceIBMIsSecurityGroups() *schema.Resource {
 		ReadContext: dataSourceIBMIsSecurityGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterVPC),
 			"resource_group": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -313,6 +314,17 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 	vpcCrn := d.Get("vpc_crn").(string)
 	vpcName := d.Get("vpc_name").(string)
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := filters.Value(isFilterResourceGroup); ok && resourceGrp == "" {
+		resourceGrp = v
+	}
+	if v, ok := filters.Value(isFilterVPC); ok && vpcId == "" {
+		vpcId = v
+	}
+
 	start := ""
 	allrecs := []vpcv1.SecurityGroup{}
 	listSecurityGroupsOptions := &vpcv1.ListSecurityGroupsOptions{}
@@ -347,6 +359,13 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(securityGroup vpcv1.SecurityGroup) isFilterAttributes {
+		return isFilterAttributes{CRN: securityGroup.CRN, Name: securityGroup.Name, ResourceGroup: securityGroup.ResourceGroup, VPC: securityGroup.VPC}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(dataSourceIBMIsSecurityGroupsID(d))
 	err = d.Set("security_groups", dataSourceSecurityGroupCollectionFlattenSecurityGroups(allrecs))
 	if err != nil {
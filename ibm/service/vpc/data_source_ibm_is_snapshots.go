@@ -22,6 +22,7 @@ func DataSourceSnapshots() *schema.Resource {
 		Read: dataSourceIBMISSnapshotsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterLifecycleState),
 
 			isSnapshotResourceGroup: {
 				Type:        schema.TypeString,
@@ -156,6 +157,10 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	start := ""
 	allrecs := []vpcv1.Snapshot{}
 	for {
@@ -163,6 +168,12 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 		if start != "" {
 			listSnapshotOptions.Start = &start
 		}
+		if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+			listSnapshotOptions.ResourceGroupID = &resourceGroup
+		}
+		if tag, ok := filters.Value(isFilterTag); ok {
+			listSnapshotOptions.Tag = &tag
+		}
 		if rgFilterOk, ok := d.GetOk(isSnapshotResourceGroup); ok {
 			rgFilter := rgFilterOk.(string)
 			listSnapshotOptions.ResourceGroupID = &rgFilter
@@ -191,6 +202,13 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(snapshot vpcv1.Snapshot) isFilterAttributes {
+		return isFilterAttributes{CRN: snapshot.CRN, Name: snapshot.Name, ResourceGroup: snapshot.ResourceGroup, LifecycleState: snapshot.LifecycleState, UserTags: snapshot.UserTags}
+	})
+	if err != nil {
+		return err
+	}
+
 	snapshotsInfo := make([]map[string]interface{}, 0)
 	for _, snapshot := range allrecs {
 		l := map[string]interface{}{
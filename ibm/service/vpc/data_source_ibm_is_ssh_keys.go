@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsSSHKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup),
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of SSH keys.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the key was created.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN for this key.",
+						},
+						isKeyFingerprint: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The fingerprint for this key.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this key.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this key.",
+						},
+						isKeyLength: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The length of this key (in bits).",
+						},
+						isKeyName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique user-defined name for this key.",
+						},
+						isKeyPublicKey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public SSH key.",
+						},
+						isKeyResourceGroup: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource group of this key.",
+						},
+						isKeyType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The crypto-system used by this key.",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of SSH keys.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsSSHKeysRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listKeysOptions := &vpcv1.ListKeysOptions{}
+	start := ""
+	allrecs := []vpcv1.Key{}
+	for {
+		if start != "" {
+			listKeysOptions.Start = &start
+		}
+		keyCollection, response, err := vpcClient.ListKeysWithContext(context, listKeysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListKeysWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListKeys", err, response)
+		}
+		start = flex.GetNext(keyCollection.Next)
+		allrecs = append(allrecs, keyCollection.Keys...)
+		if start == "" {
+			break
+		}
+	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(key vpcv1.Key) isFilterAttributes {
+		return isFilterAttributes{CRN: key.CRN, Name: key.Name, ResourceGroup: key.ResourceGroup}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]map[string]interface{}, 0, len(allrecs))
+	for _, key := range allrecs {
+		keys = append(keys, dataSourceIBMIsSSHKeysToMap(key))
+	}
+
+	d.SetId(dataSourceIBMIsSSHKeysID(d))
+	if err = d.Set("keys", keys); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting keys %s", err))
+	}
+	if err = d.Set("total_count", len(keys)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_count: %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsSSHKeysID returns a reasonable ID for the list.
+func dataSourceIBMIsSSHKeysID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceIBMIsSSHKeysToMap(key vpcv1.Key) map[string]interface{} {
+	keyMap := map[string]interface{}{
+		"crn":            key.CRN,
+		isKeyFingerprint: key.Fingerprint,
+		"href":           key.Href,
+		"id":             key.ID,
+		isKeyName:        key.Name,
+		isKeyPublicKey:   key.PublicKey,
+		isKeyType:        key.Type,
+	}
+	if key.CreatedAt != nil {
+		keyMap["created_at"] = key.CreatedAt.String()
+	}
+	if key.Length != nil {
+		keyMap[isKeyLength] = int(*key.Length)
+	}
+	if key.ResourceGroup != nil {
+		keyMap[isKeyResourceGroup] = key.ResourceGroup.ID
+	}
+	return keyMap
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISSSHKeysDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tfssh-keys-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSSHKeysDataSourceConfig(publicKey, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_ssh_keys.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_ssh_keys.test", "keys.0.name", name),
+					resource.TestCheckResourceAttrSet("data.ibm_is_ssh_keys.test", "keys.0.fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSSHKeysDataSourceConfig(publicKey, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_ssh_key" "key" {
+		name       = "%s"
+		public_key = "%s"
+	}
+	data "ibm_is_ssh_keys" "test" {
+		filter {
+			name   = "name"
+			values = ["^${ibm_is_ssh_key.key.name}$"]
+		}
+	}`, name, publicKey)
+}
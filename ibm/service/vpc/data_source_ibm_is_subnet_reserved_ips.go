@@ -25,6 +25,7 @@ func DataSourceIBMISReservedIPs() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSdataSourceIBMISReservedIPsRead,
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			/*
 				Request Parameters
 				==================
@@ -117,6 +118,10 @@ func dataSdataSourceIBMISReservedIPsRead(d *schema.ResourceData, meta interface{
 	}
 
 	subnetID := d.Get(isSubNetID).(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 
 	// Flatten all the reserved IPs
 	start := ""
@@ -138,6 +143,12 @@ func dataSdataSourceIBMISReservedIPsRead(d *schema.ResourceData, meta interface{
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(data vpcv1.ReservedIP) isFilterAttributes {
+		return isFilterAttributes{Name: data.Name, LifecycleState: data.LifecycleState}
+	})
+	if err != nil {
+		return err
+	}
 
 	// Now store all the reserved IP info with their response tags
 	reservedIPs := []map[string]interface{}{}
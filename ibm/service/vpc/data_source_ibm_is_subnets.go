@@ -24,6 +24,7 @@ func DataSourceIBMISSubnets() *schema.Resource {
 		Read: dataSourceIBMISSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterVPC, isFilterLifecycleState),
 			isSubnetResourceGroupID: {
 				Type:        schema.TypeString,
 				Description: "Resource Group ID",
@@ -134,7 +135,15 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		resourceTableName = v.(string)
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
+
 	options := &vpcv1.ListSubnetsOptions{}
+	if v, ok := filters.Value(isFilterResourceGroup); ok && resourceGroup == "" {
+		resourceGroup = v
+	}
 	if resourceGroup != "" {
 		options.SetResourceGroupID(resourceGroup)
 	}
@@ -159,6 +168,13 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 			break
 		}
 	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(subnet vpcv1.Subnet) isFilterAttributes {
+		return isFilterAttributes{CRN: subnet.CRN, Name: subnet.Name, ResourceGroup: subnet.ResourceGroup, Zone: subnet.Zone, VPC: subnet.VPC, LifecycleState: subnet.Status}
+	})
+	if err != nil {
+		return err
+	}
 	subnetsInfo := make([]map[string]interface{}, 0)
 	for _, subnet := range allrecs {
 
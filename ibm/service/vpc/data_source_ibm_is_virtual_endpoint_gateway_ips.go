@@ -17,6 +17,7 @@ func DataSourceIBMISEndpointGatewayIPs() *schema.Resource {
 		Read:     dataSourceIBMISEndpointGatewayIPsRead,
 		Importer: &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			isVirtualEndpointGatewayID: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -93,6 +94,10 @@ func dataSourceIBMISEndpointGatewayIPsRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 
 	start := ""
 	allrecs := []vpcv1.ReservedIP{}
@@ -111,6 +116,12 @@ func dataSourceIBMISEndpointGatewayIPsRead(d *schema.ResourceData, meta interfac
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(ip vpcv1.ReservedIP) isFilterAttributes {
+		return isFilterAttributes{Name: ip.Name, LifecycleState: ip.LifecycleState}
+	})
+	if err != nil {
+		return err
+	}
 	endpointGatewayIPs := []map[string]interface{}{}
 	for _, ip := range allrecs {
 		ipsOutput := map[string]interface{}{}
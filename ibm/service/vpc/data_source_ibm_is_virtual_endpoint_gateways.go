@@ -22,6 +22,7 @@ func DataSourceIBMISEndpointGateways() *schema.Resource {
 		Read:     dataSourceIBMISEndpointGatewaysRead,
 		Importer: &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterVPC, isFilterLifecycleState),
 			isVirtualEndpointGateways: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -140,10 +141,17 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	start := ""
 	allrecs := []vpcv1.EndpointGateway{}
 	for {
 		options := sess.NewListEndpointGatewaysOptions()
+		if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+			options.ResourceGroupID = &resourceGroup
+		}
 		if start != "" {
 			options.Start = &start
 		}
@@ -157,6 +165,13 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 			break
 		}
 	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(endpointGateway vpcv1.EndpointGateway) isFilterAttributes {
+		return isFilterAttributes{CRN: endpointGateway.CRN, Name: endpointGateway.Name, ResourceGroup: endpointGateway.ResourceGroup, VPC: endpointGateway.VPC, LifecycleState: endpointGateway.LifecycleState}
+	})
+	if err != nil {
+		return err
+	}
 	endpointGateways := []map[string]interface{}{}
 	for _, endpointGateway := range allrecs {
 		endpointGatewayOutput := map[string]interface{}{}
@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsVolumes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVolumesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterZone, isFilterLifecycleState),
+			"volumes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of volumes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVolumeBandwidth: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum bandwidth (in megabits per second) for the volume.",
+						},
+						isVolumeCapacity: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The capacity to use for the volume (in gigabytes).",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the volume was created.",
+						},
+						isVolumeCrn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN for this volume.",
+						},
+						isVolumeEncryptionKey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the root key used to wrap the data encryption key for the volume.",
+						},
+						isVolumeEncryptionType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of encryption used on the volume.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this volume.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this volume.",
+						},
+						isVolumeIops: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum I/O operations per second (IOPS) for this volume.",
+						},
+						isVolumeName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique user-defined name for this volume.",
+						},
+						isVolumeProfileName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the profile this volume uses.",
+						},
+						isVolumeResourceGroup: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource group of this volume.",
+						},
+						isVolumeSourceSnapshot: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the snapshot from which this volume was cloned.",
+						},
+						isVolumeStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the volume.",
+						},
+						isVolumeStatusReasons: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The reasons for the current status (if any).",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isVolumeStatusReasonsCode: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A snake case string succinctly identifying the status reason.",
+									},
+									isVolumeStatusReasonsMessage: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "An explanation of the status reason.",
+									},
+									isVolumeStatusReasonsMoreInfo: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Link to documentation about this status reason.",
+									},
+								},
+							},
+						},
+						isVolumeTags: {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Set:         flex.ResourceIBMVPCHash,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags of this volume.",
+						},
+						isVolumeZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone this volume resides in.",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of volumes.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listVolumesOptions := &vpcv1.ListVolumesOptions{}
+	if zone, ok := filters.Value(isFilterZone); ok {
+		listVolumesOptions.ZoneName = &zone
+	}
+	start := ""
+	allrecs := []vpcv1.Volume{}
+	for {
+		if start != "" {
+			listVolumesOptions.Start = &start
+		}
+		volumeCollection, response, err := vpcClient.ListVolumesWithContext(context, listVolumesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListVolumesWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListVolumes", err, response)
+		}
+		start = flex.GetNext(volumeCollection.Next)
+		allrecs = append(allrecs, volumeCollection.Volumes...)
+		if start == "" {
+			break
+		}
+	}
+
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(volume vpcv1.Volume) isFilterAttributes {
+		return isFilterAttributes{CRN: volume.CRN, Name: volume.Name, ResourceGroup: volume.ResourceGroup, Zone: volume.Zone, LifecycleState: volume.Status, UserTags: volume.UserTags}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	volumes := make([]map[string]interface{}, 0, len(allrecs))
+	for _, volume := range allrecs {
+		volumes = append(volumes, dataSourceIBMIsVolumesToMap(volume))
+	}
+
+	d.SetId(dataSourceIBMIsVolumesID(d))
+	if err = d.Set("volumes", volumes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting volumes %s", err))
+	}
+	if err = d.Set("total_count", len(volumes)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_count: %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsVolumesID returns a reasonable ID for the list.
+func dataSourceIBMIsVolumesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceIBMIsVolumesToMap(volume vpcv1.Volume) map[string]interface{} {
+	volumeMap := map[string]interface{}{
+		isVolumeCrn:            volume.CRN,
+		isVolumeEncryptionType: volume.Encryption,
+		"href":                 volume.Href,
+		"id":                   volume.ID,
+		isVolumeName:           volume.Name,
+		isVolumeStatus:         volume.Status,
+		isVolumeTags:           flex.NewStringSet(flex.ResourceIBMVPCHash, volume.UserTags),
+	}
+	if volume.Bandwidth != nil {
+		volumeMap[isVolumeBandwidth] = int(*volume.Bandwidth)
+	}
+	if volume.Capacity != nil {
+		volumeMap[isVolumeCapacity] = int(*volume.Capacity)
+	}
+	if volume.CreatedAt != nil {
+		volumeMap["created_at"] = volume.CreatedAt.String()
+	}
+	if volume.EncryptionKey != nil {
+		volumeMap[isVolumeEncryptionKey] = volume.EncryptionKey.CRN
+	}
+	if volume.Iops != nil {
+		volumeMap[isVolumeIops] = int(*volume.Iops)
+	}
+	if volume.Profile != nil {
+		volumeMap[isVolumeProfileName] = volume.Profile.Name
+	}
+	if volume.ResourceGroup != nil {
+		volumeMap[isVolumeResourceGroup] = volume.ResourceGroup.ID
+	}
+	if volume.SourceSnapshot != nil {
+		volumeMap[isVolumeSourceSnapshot] = volume.SourceSnapshot.ID
+	}
+	statusReasons := []map[string]interface{}{}
+	for _, statusReason := range volume.StatusReasons {
+		statusReasons = append(statusReasons, map[string]interface{}{
+			isVolumeStatusReasonsCode:     statusReason.Code,
+			isVolumeStatusReasonsMessage:  statusReason.Message,
+			isVolumeStatusReasonsMoreInfo: statusReason.MoreInfo,
+		})
+	}
+	volumeMap[isVolumeStatusReasons] = statusReasons
+	if volume.Zone != nil {
+		volumeMap[isVolumeZone] = volume.Zone.Name
+	}
+	return volumeMap
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/fakevpc"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVolumesDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-vols-%d", acctest.RandIntRange(10, 100))
+	zone := "us-south-1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVolumesDataSourceConfig(name, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_volumes.test", "volumes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_volumes.test", "volumes.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_is_volumes.test", "volumes.0.zone", zone),
+					resource.TestCheckResourceAttrSet("data.ibm_is_volumes.test", "volumes.0.crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVolumesDataSourceConfig(name, zone string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_volume" "testacc_volume" {
+		name    = "%s"
+		profile = "10iops-tier"
+		zone    = "%s"
+	}
+	data "ibm_is_volumes" "test" {
+		filter {
+			name   = "name"
+			values = ["^${ibm_is_volume.testacc_volume.name}$"]
+		}
+		filter {
+			name   = "zone"
+			values = [ibm_is_volume.testacc_volume.zone]
+		}
+	}`, name, zone)
+}
+
+func TestIBMISVolumes_fakeAPIFilter(t *testing.T) {
+	t.Parallel()
+	server := fakevpc.NewServer()
+	defer server.Close()
+	meta := server.ClientSession()
+
+	sess, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, volume := range []struct{ name, zone string }{
+		{"tf-fake-vol-1", "us-south-1"},
+		{"tf-fake-vol-2", "us-south-2"},
+		{"other-vol", "us-south-1"},
+	} {
+		_, _, err := sess.CreateVolume(&vpcv1.CreateVolumeOptions{
+			VolumePrototype: &vpcv1.VolumePrototype{
+				Name:     &volume.name,
+				Profile:  &vpcv1.VolumeProfileIdentityByName{Name: core.StringPtr("general-purpose")},
+				Zone:     &vpcv1.ZoneIdentityByName{Name: &volume.zone},
+				Capacity: core.Int64Ptr(10),
+			},
+		})
+		if err != nil {
+			t.Fatalf("Error creating the volume %s: %s", volume.name, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		filters []interface{}
+		want    []string
+	}{
+		{
+			name: "no filter",
+			want: []string{"tf-fake-vol-1", "tf-fake-vol-2", "other-vol"},
+		},
+		{
+			name:    "name",
+			filters: []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"^tf-fake-"}}},
+			want:    []string{"tf-fake-vol-1", "tf-fake-vol-2"},
+		},
+		{
+			name: "name and zone",
+			filters: []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"^tf-fake-"}},
+				map[string]interface{}{"name": "zone", "values": []interface{}{"us-south-1"}},
+			},
+			want: []string{"tf-fake-vol-1"},
+		},
+		{
+			name: "several values",
+			filters: []interface{}{
+				map[string]interface{}{"name": "zone", "values": []interface{}{"us-south-1", "us-south-2"}},
+				map[string]interface{}{"name": "resource_group", "values": []interface{}{fakevpc.DefaultResourceGroupID}},
+			},
+			want: []string{"tf-fake-vol-1", "tf-fake-vol-2", "other-vol"},
+		},
+		{
+			name:    "no match",
+			filters: []interface{}{map[string]interface{}{"name": "lifecycle_state", "values": []interface{}{"failed"}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := vpc.DataSourceIBMIsVolumes()
+			d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"filter": tc.filters})
+			if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("Error reading the volumes: %v", diags)
+			}
+			got := map[string]bool{}
+			for _, volume := range d.Get("volumes").([]interface{}) {
+				got[volume.(map[string]interface{})["name"].(string)] = true
+			}
+			if len(got) != len(tc.want) || d.Get("total_count") != len(tc.want) {
+				t.Fatalf("Expected the volumes %v, got %v", tc.want, got)
+			}
+			for _, name := range tc.want {
+				if !got[name] {
+					t.Errorf("Expected the volume %s to be listed, got %v", name, got)
+				}
+			}
+		})
+	}
+
+	// An invalid name regular expression is an error
+	r := vpc.DataSourceIBMIsVolumes()
+	d := fakeResourceData(t, r, time.Minute, map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "name", "values": []interface{}{"("}}},
+	})
+	if diags := r.ReadContext(context.Background(), d, meta); !diags.HasError() {
+		t.Error("Expected an error for an invalid name filter")
+	}
+}
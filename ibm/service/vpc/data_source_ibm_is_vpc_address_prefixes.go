@@ -22,6 +22,7 @@ func DataSourceIbmIsVpcAddressPrefixes() *schema.Resource {
 		ReadContext: dataSourceIbmIsVpcAddressPrefixRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterZone),
 			"vpc": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -105,6 +106,10 @@ func dataSourceIbmIsVpcAddressPrefixRead(context context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	start := ""
 	allrecs := []vpcv1.AddressPrefix{}
@@ -127,6 +132,12 @@ func dataSourceIbmIsVpcAddressPrefixRead(context context.Context, d *schema.Reso
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(addressPrefix vpcv1.AddressPrefix) isFilterAttributes {
+		return isFilterAttributes{Name: addressPrefix.Name, Zone: addressPrefix.Zone}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
 	var matchAddressPrefixes []vpcv1.AddressPrefix
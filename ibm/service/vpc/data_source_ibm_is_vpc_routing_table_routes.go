@@ -31,6 +31,7 @@ func DataSourceIBMISVPCRoutingTableRoutes() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIBMISVPCRoutingTableRoutesList,
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterZone, isFilterLifecycleState),
 			isRoutingTableRouteVpcID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -107,6 +108,10 @@ func dataSourceIBMISVPCRoutingTableRoutesList(d *schema.ResourceData, meta inter
 
 	vpcID := d.Get(isRoutingTableRouteVpcID).(string)
 	routingTableID := d.Get(isRouteTableID).(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	start := ""
 	allrecs := []vpcv1.Route{}
 	for {
@@ -125,6 +130,12 @@ func dataSourceIBMISVPCRoutingTableRoutesList(d *schema.ResourceData, meta inter
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(route vpcv1.Route) isFilterAttributes {
+		return isFilterAttributes{Name: route.Name, Zone: route.Zone, LifecycleState: route.LifecycleState}
+	})
+	if err != nil {
+		return err
+	}
 
 	vpcRoutingTableRoutes := make([]map[string]interface{}, 0)
 
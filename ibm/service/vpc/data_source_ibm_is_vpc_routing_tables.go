@@ -34,6 +34,7 @@ func DataSourceIBMISVPCRoutingTables() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIBMISVPCRoutingTablesList,
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
 			isVpcID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -147,6 +148,10 @@ func dataSourceIBMISVPCRoutingTablesList(d *schema.ResourceData, meta interface{
 	}
 
 	vpcID := d.Get(isVpcID).(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 
 	start := ""
 	allrecs := []vpcv1.RoutingTable{}
@@ -166,6 +171,12 @@ func dataSourceIBMISVPCRoutingTablesList(d *schema.ResourceData, meta interface{
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(routingTable vpcv1.RoutingTable) isFilterAttributes {
+		return isFilterAttributes{Name: routingTable.Name, LifecycleState: routingTable.LifecycleState}
+	})
+	if err != nil {
+		return err
+	}
 
 	vpcRoutingTables := make([]map[string]interface{}, 0)
 	for _, routingTable := range allrecs {
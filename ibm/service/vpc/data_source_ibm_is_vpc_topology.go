@@ -0,0 +1,531 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// The relations of the edges of the VPC topology.
+const (
+	isVPCTopologyAttachedTo = "attached_to"
+	isVPCTopologyContains   = "contains"
+	isVPCTopologyHas        = "has"
+	isVPCTopologyProtects   = "protects"
+	isVPCTopologyTargets    = "targets"
+	isVPCTopologyUses       = "uses"
+)
+
+func DataSourceIBMIsVPCTopology() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCTopologyRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the VPC.",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource, if it has one.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+			"edges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The relations between the resources of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the source node.",
+						},
+						"relation": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The relation of the source node to the target node.",
+						},
+						"to": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the target node.",
+						},
+					},
+				},
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The topology as a JSON document.",
+			},
+			"dot": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The topology as a Graphviz DOT graph.",
+			},
+		},
+	}
+}
+
+type isVPCTopologyNode struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+	CRN  string `json:"crn,omitempty"`
+}
+
+type isVPCTopologyEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+}
+
+// isVPCTopology is the graph of the resources of a VPC.
+type isVPCTopology struct {
+	VPC   string               `json:"vpc"`
+	Nodes []*isVPCTopologyNode `json:"nodes"`
+	Edges []isVPCTopologyEdge  `json:"edges"`
+
+	nodes map[string]*isVPCTopologyNode
+	edges map[isVPCTopologyEdge]bool
+}
+
+// isVPCTopologyWalker adds the resources of one list call to the graph.
+type isVPCTopologyWalker func(context.Context, *vpcv1.VpcV1, *isVPCTopology) diag.Diagnostics
+
+func newIsVPCTopology(vpc string) *isVPCTopology {
+	return &isVPCTopology{
+		VPC:   vpc,
+		nodes: map[string]*isVPCTopologyNode{},
+		edges: map[isVPCTopologyEdge]bool{},
+	}
+}
+
+// addNode adds a resource to the graph, or completes the name and CRN of a
+// resource already added from a reference.
+func (t *isVPCTopology) addNode(id *string, resourceType string, name, crn *string) {
+	if id == nil {
+		return
+	}
+	node, ok := t.nodes[*id]
+	if !ok {
+		node = &isVPCTopologyNode{ID: *id, Type: resourceType}
+		t.nodes[*id] = node
+	}
+	if node.Name == "" && name != nil {
+		node.Name = *name
+	}
+	if node.CRN == "" && crn != nil {
+		node.CRN = *crn
+	}
+}
+
+func (t *isVPCTopology) addEdge(from, to *string, relation string) {
+	if from != nil && to != nil {
+		t.edges[isVPCTopologyEdge{*from, *to, relation}] = true
+	}
+}
+
+// sort orders the nodes by type, name and ID, and the edges by their nodes,
+// so that the outputs don't change as long as the VPC doesn't.
+func (t *isVPCTopology) sort() {
+	t.Nodes = make([]*isVPCTopologyNode, 0, len(t.nodes))
+	for _, node := range t.nodes {
+		t.Nodes = append(t.Nodes, node)
+	}
+	sort.Slice(t.Nodes, func(i, j int) bool {
+		a, b := t.Nodes[i], t.Nodes[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+	t.Edges = make([]isVPCTopologyEdge, 0, len(t.edges))
+	for edge := range t.edges {
+		t.Edges = append(t.Edges, edge)
+	}
+	sort.Slice(t.Edges, func(i, j int) bool {
+		a, b := t.Edges[i], t.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Relation < b.Relation
+	})
+}
+
+// dot returns the sorted graph in the Graphviz DOT language.
+func (t *isVPCTopology) dot() string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", quote(t.VPC))
+	b.WriteString("  rankdir=\"LR\";\n  node [shape=\"box\"];\n")
+	for _, node := range t.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", quote(node.ID), quote(node.Name+"\n"+node.Type))
+	}
+	for _, edge := range t.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", quote(edge.From), quote(edge.To), quote(edge.Relation))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dataSourceIBMIsVPCTopologyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcID := d.Get("vpc").(string)
+	vpc, response, err := sess.GetVPCWithContext(context, &vpcv1.GetVPCOptions{ID: &vpcID})
+	if err != nil {
+		log.Printf("[DEBUG] GetVPCWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "GetVPC", err, response)
+	}
+	topology := newIsVPCTopology(vpcID)
+	topology.addNode(vpc.ID, "vpc", vpc.Name, vpc.CRN)
+	for _, walk := range []isVPCTopologyWalker{
+		isVPCTopologySubnets,
+		isVPCTopologyPublicGateways,
+		isVPCTopologyRoutingTables,
+		isVPCTopologyNetworkACLs,
+		isVPCTopologySecurityGroups,
+		isVPCTopologyInstances,
+		isVPCTopologyLoadBalancers,
+		isVPCTopologyEndpointGateways,
+	} {
+		if diags := walk(context, sess, topology); diags != nil {
+			return diags
+		}
+	}
+	topology.sort()
+
+	nodes := make([]map[string]interface{}, 0, len(topology.Nodes))
+	for _, node := range topology.Nodes {
+		nodes = append(nodes, map[string]interface{}{
+			"crn":  node.CRN,
+			"id":   node.ID,
+			"name": node.Name,
+			"type": node.Type,
+		})
+	}
+	edges := make([]map[string]interface{}, 0, len(topology.Edges))
+	for _, edge := range topology.Edges {
+		edges = append(edges, map[string]interface{}{
+			"from":     edge.From,
+			"relation": edge.Relation,
+			"to":       edge.To,
+		})
+	}
+	topologyJSON, err := json.MarshalIndent(topology, "", "  ")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling the topology of the VPC %s: %s", vpcID, err))
+	}
+
+	d.SetId(vpcID)
+	if err = d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nodes %s", err))
+	}
+	if err = d.Set("edges", edges); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting edges %s", err))
+	}
+	if err = d.Set("json", string(topologyJSON)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting json %s", err))
+	}
+	if err = d.Set("dot", topology.dot()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting dot %s", err))
+	}
+	return nil
+}
+
+func isVPCTopologySubnets(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListSubnetsOptions{}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		subnets, response, err := sess.ListSubnetsWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListSubnets", err, response)
+		}
+		for _, subnet := range subnets.Subnets {
+			if subnet.VPC == nil || *subnet.VPC.ID != topology.VPC {
+				continue
+			}
+			topology.addNode(subnet.ID, "subnet", subnet.Name, subnet.CRN)
+			topology.addEdge(&topology.VPC, subnet.ID, isVPCTopologyContains)
+			if subnet.PublicGateway != nil {
+				topology.addNode(subnet.PublicGateway.ID, "public_gateway", subnet.PublicGateway.Name, subnet.PublicGateway.CRN)
+				topology.addEdge(subnet.ID, subnet.PublicGateway.ID, isVPCTopologyUses)
+			}
+			if subnet.NetworkACL != nil {
+				topology.addNode(subnet.NetworkACL.ID, "network_acl", subnet.NetworkACL.Name, subnet.NetworkACL.CRN)
+				topology.addEdge(subnet.ID, subnet.NetworkACL.ID, isVPCTopologyUses)
+			}
+			if subnet.RoutingTable != nil {
+				topology.addNode(subnet.RoutingTable.ID, "routing_table", subnet.RoutingTable.Name, nil)
+				topology.addEdge(subnet.ID, subnet.RoutingTable.ID, isVPCTopologyUses)
+			}
+		}
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func isVPCTopologyPublicGateways(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListPublicGatewaysOptions{}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		publicGateways, response, err := sess.ListPublicGatewaysWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListPublicGatewaysWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListPublicGateways", err, response)
+		}
+		for _, publicGateway := range publicGateways.PublicGateways {
+			if publicGateway.VPC == nil || *publicGateway.VPC.ID != topology.VPC {
+				continue
+			}
+			topology.addNode(publicGateway.ID, "public_gateway", publicGateway.Name, publicGateway.CRN)
+			topology.addEdge(&topology.VPC, publicGateway.ID, isVPCTopologyContains)
+		}
+		start = flex.GetNext(publicGateways.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func isVPCTopologyRoutingTables(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListVPCRoutingTablesOptions{VPCID: &topology.VPC}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		routingTables, response, err := sess.ListVPCRoutingTablesWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListVPCRoutingTablesWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListVPCRoutingTables", err, response)
+		}
+		for _, routingTable := range routingTables.RoutingTables {
+			topology.addNode(routingTable.ID, "routing_table", routingTable.Name, nil)
+			topology.addEdge(&topology.VPC, routingTable.ID, isVPCTopologyContains)
+		}
+		start = flex.GetNext(routingTables.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func isVPCTopologyNetworkACLs(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListNetworkAclsOptions{}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		networkACLs, response, err := sess.ListNetworkAclsWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListNetworkAclsWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListNetworkAcls", err, response)
+		}
+		for _, networkACL := range networkACLs.NetworkAcls {
+			if networkACL.VPC == nil || *networkACL.VPC.ID != topology.VPC {
+				continue
+			}
+			topology.addNode(networkACL.ID, "network_acl", networkACL.Name, networkACL.CRN)
+			topology.addEdge(&topology.VPC, networkACL.ID, isVPCTopologyContains)
+		}
+		start = flex.GetNext(networkACLs.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func isVPCTopologySecurityGroups(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListSecurityGroupsOptions{VPCID: &topology.VPC}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		securityGroups, response, err := sess.ListSecurityGroupsWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListSecurityGroupsWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListSecurityGroups", err, response)
+		}
+		for _, securityGroup := range securityGroups.SecurityGroups {
+			topology.addNode(securityGroup.ID, "security_group", securityGroup.Name, securityGroup.CRN)
+			topology.addEdge(&topology.VPC, securityGroup.ID, isVPCTopologyContains)
+			for _, targetIntf := range securityGroup.Targets {
+				target, ok := targetIntf.(*vpcv1.SecurityGroupTargetReference)
+				if !ok || target.ResourceType == nil {
+					continue
+				}
+				topology.addNode(target.ID, *target.ResourceType, target.Name, target.CRN)
+				topology.addEdge(securityGroup.ID, target.ID, isVPCTopologyProtects)
+			}
+		}
+		start = flex.GetNext(securityGroups.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func isVPCTopologyInstances(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListInstancesOptions{VPCID: &topology.VPC}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		instances, response, err := sess.ListInstancesWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListInstancesWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListInstances", err, response)
+		}
+		for _, instance := range instances.Instances {
+			topology.addNode(instance.ID, "instance", instance.Name, instance.CRN)
+			topology.addEdge(&topology.VPC, instance.ID, isVPCTopologyContains)
+			for _, networkInterface := range instance.NetworkInterfaces {
+				topology.addNode(networkInterface.ID, "network_interface", networkInterface.Name, nil)
+				topology.addEdge(instance.ID, networkInterface.ID, isVPCTopologyHas)
+				if networkInterface.Subnet != nil {
+					topology.addEdge(networkInterface.ID, networkInterface.Subnet.ID, isVPCTopologyAttachedTo)
+				}
+			}
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+// isVPCTopologyLoadBalancers adds the load balancers attached to the subnets
+// of the VPC, which must be walked first.
+func isVPCTopologyLoadBalancers(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListLoadBalancersOptions{}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		loadBalancers, response, err := sess.ListLoadBalancersWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListLoadBalancersWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListLoadBalancers", err, response)
+		}
+		for _, loadBalancer := range loadBalancers.LoadBalancers {
+			inVPC := false
+			for _, subnet := range loadBalancer.Subnets {
+				if node, ok := topology.nodes[*subnet.ID]; ok && node.Type == "subnet" {
+					inVPC = true
+				}
+			}
+			if !inVPC {
+				continue
+			}
+			topology.addNode(loadBalancer.ID, "load_balancer", loadBalancer.Name, loadBalancer.CRN)
+			topology.addEdge(&topology.VPC, loadBalancer.ID, isVPCTopologyContains)
+			for _, subnet := range loadBalancer.Subnets {
+				topology.addEdge(loadBalancer.ID, subnet.ID, isVPCTopologyAttachedTo)
+			}
+		}
+		start = flex.GetNext(loadBalancers.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func isVPCTopologyEndpointGateways(context context.Context, sess *vpcv1.VpcV1, topology *isVPCTopology) diag.Diagnostics {
+	options := &vpcv1.ListEndpointGatewaysOptions{}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		endpointGateways, response, err := sess.ListEndpointGatewaysWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] ListEndpointGatewaysWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListEndpointGateways", err, response)
+		}
+		for _, endpointGateway := range endpointGateways.EndpointGateways {
+			if endpointGateway.VPC == nil || *endpointGateway.VPC.ID != topology.VPC {
+				continue
+			}
+			topology.addNode(endpointGateway.ID, "endpoint_gateway", endpointGateway.Name, endpointGateway.CRN)
+			topology.addEdge(&topology.VPC, endpointGateway.ID, isVPCTopologyContains)
+			// The services have no ID, they are identified by their CRN or name
+			if target, ok := endpointGateway.Target.(*vpcv1.EndpointGatewayTarget); ok && target.ResourceType != nil {
+				id := target.CRN
+				if id == nil {
+					id = target.Name
+				}
+				topology.addNode(id, *target.ResourceType, target.Name, target.CRN)
+				topology.addEdge(endpointGateway.ID, id, isVPCTopologyTargets)
+			}
+		}
+		start = flex.GetNext(endpointGateways.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
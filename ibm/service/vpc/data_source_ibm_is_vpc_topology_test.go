@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/fakevpc"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCTopologyDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-topology-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-topology-subnet-%d", acctest.RandIntRange(10, 100))
+	node := "data.ibm_is_vpc_topology.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCTopologyDataSourceConfig(vpcname, subnetname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(node, "id", "ibm_is_vpc.test", "id"),
+					resource.TestCheckResourceAttrSet(node, "nodes.#"),
+					resource.TestCheckResourceAttrSet(node, "edges.#"),
+					resource.TestCheckResourceAttrSet(node, "json"),
+					resource.TestCheckResourceAttrSet(node, "dot"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCTopologyDataSourceConfig(vpcname, subnetname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "test" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.test.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	data "ibm_is_vpc_topology" "test" {
+		vpc = ibm_is_subnet.test.vpc
+	}`, vpcname, subnetname, acc.ISZoneName)
+}
+
+func TestIBMISVPCTopology_fakeAPI(t *testing.T) {
+	t.Parallel()
+	server := fakevpc.NewServer()
+	defer server.Close()
+	meta := server.ClientSession()
+
+	sess, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcResult, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("tf-fake-vpc")})
+	if err != nil {
+		t.Fatalf("Error creating the VPC: %s", err)
+	}
+	// A second VPC whose resources must not show up
+	if _, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("tf-fake-other-vpc")}); err != nil {
+		t.Fatalf("Error creating the VPC: %s", err)
+	}
+	subnet, _, err := sess.CreateSubnet(&vpcv1.CreateSubnetOptions{
+		SubnetPrototype: &vpcv1.SubnetPrototype{
+			Name:                  core.StringPtr("tf-fake-subnet"),
+			VPC:                   &vpcv1.VPCIdentity{ID: vpcResult.ID},
+			Zone:                  &vpcv1.ZoneIdentity{Name: core.StringPtr("us-south-1")},
+			TotalIpv4AddressCount: core.Int64Ptr(256),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the subnet: %s", err)
+	}
+	instance, _, err := sess.CreateInstance(&vpcv1.CreateInstanceOptions{
+		InstancePrototype: &vpcv1.InstancePrototype{
+			Name:    core.StringPtr("tf-fake-instance"),
+			VPC:     &vpcv1.VPCIdentity{ID: vpcResult.ID},
+			Zone:    &vpcv1.ZoneIdentity{Name: core.StringPtr("us-south-1")},
+			Profile: &vpcv1.InstanceProfileIdentity{Name: core.StringPtr("bx2-2x8")},
+			Image:   &vpcv1.ImageIdentity{ID: core.StringPtr("fake-image")},
+			PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{
+				Subnet: &vpcv1.SubnetIdentity{ID: subnet.ID},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the instance: %s", err)
+	}
+	nicID := *instance.PrimaryNetworkInterface.ID
+
+	r := vpc.DataSourceIBMIsVPCTopology()
+	d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"vpc": *vpcResult.ID})
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Error reading the topology: %v", diags)
+	}
+	if d.Id() != *vpcResult.ID {
+		t.Errorf("Expected the ID %s, got %s", *vpcResult.ID, d.Id())
+	}
+
+	types := map[string]string{}
+	for _, nodeIntf := range d.Get("nodes").([]interface{}) {
+		node := nodeIntf.(map[string]interface{})
+		types[node["id"].(string)] = node["type"].(string)
+	}
+	expectedTypes := map[string]string{
+		*vpcResult.ID:                      "vpc",
+		*subnet.ID:                         "subnet",
+		*vpcResult.DefaultNetworkACL.ID:    "network_acl",
+		*vpcResult.DefaultRoutingTable.ID:  "routing_table",
+		*vpcResult.DefaultSecurityGroup.ID: "security_group",
+		*instance.ID:                       "instance",
+		nicID:                              "network_interface",
+	}
+	if len(types) != len(expectedTypes) {
+		t.Errorf("Expected the nodes %v, got %v", expectedTypes, types)
+	}
+	for id, resourceType := range expectedTypes {
+		if types[id] != resourceType {
+			t.Errorf("Expected the node %s of type %s, got %q", id, resourceType, types[id])
+		}
+	}
+
+	edges := map[string]bool{}
+	for _, edgeIntf := range d.Get("edges").([]interface{}) {
+		edge := edgeIntf.(map[string]interface{})
+		edges[fmt.Sprintf("%s %s %s", edge["from"], edge["relation"], edge["to"])] = true
+	}
+	for _, edge := range []string{
+		*vpcResult.ID + " contains " + *subnet.ID,
+		*vpcResult.ID + " contains " + *instance.ID,
+		*subnet.ID + " uses " + *vpcResult.DefaultNetworkACL.ID,
+		*subnet.ID + " uses " + *vpcResult.DefaultRoutingTable.ID,
+		*instance.ID + " has " + nicID,
+		nicID + " attached_to " + *subnet.ID,
+	} {
+		if !edges[edge] {
+			t.Errorf("Expected the edge %q, got %v", edge, edges)
+		}
+	}
+
+	var topology struct {
+		VPC   string                   `json:"vpc"`
+		Nodes []map[string]interface{} `json:"nodes"`
+		Edges []map[string]interface{} `json:"edges"`
+	}
+	if err := json.Unmarshal([]byte(d.Get("json").(string)), &topology); err != nil {
+		t.Fatalf("Error parsing the JSON topology: %s", err)
+	}
+	if topology.VPC != *vpcResult.ID || len(topology.Nodes) != len(types) || len(topology.Edges) != len(edges) {
+		t.Errorf("Expected the JSON topology to match the nodes and edges, got %s", d.Get("json"))
+	}
+
+	dot := d.Get("dot").(string)
+	if !strings.HasPrefix(dot, fmt.Sprintf("digraph %q {", *vpcResult.ID)) {
+		t.Errorf("Expected a DOT graph of the VPC, got %s", dot)
+	}
+	if edge := fmt.Sprintf("%q -> %q [label=\"has\"];", *instance.ID, nicID); !strings.Contains(dot, edge) {
+		t.Errorf("Expected the DOT graph to contain %s, got %s", edge, dot)
+	}
+}
@@ -28,6 +28,7 @@ func DataSourceIBMISVPCs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISVPCListRead,
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterLifecycleState),
 			isVPCs: {
 				Type:        schema.TypeList,
 				Description: "Collection of VPCs",
@@ -304,11 +305,18 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	start := ""
 	allrecs := []vpcv1.VPC{}
 	for {
 
 		listOptions := &vpcv1.ListVpcsOptions{}
+		if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+			listOptions.ResourceGroupID = &resourceGroup
+		}
 		if start != "" {
 			listOptions.Start = &start
 		}
@@ -324,6 +332,13 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 		}
 	}
 
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(vpc vpcv1.VPC) isFilterAttributes {
+		return isFilterAttributes{CRN: vpc.CRN, Name: vpc.Name, ResourceGroup: vpc.ResourceGroup, LifecycleState: vpc.Status}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	vpcs := make([]map[string]interface{}, 0)
 	for _, vpc := range allrecs {
 
@@ -24,6 +24,8 @@ func DataSourceIBMISVPNGatewayConnections() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterLifecycleState),
+
 			isVPNGatewayID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -160,12 +162,23 @@ func dataSourceIBMVPNGatewayConnectionsRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 	vpngatewayID := d.Get(isVPNGatewayID).(string)
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
 	listvpnGWConnectionOptions := sess.NewListVPNGatewayConnectionsOptions(vpngatewayID)
 
 	availableVPNGatewayConnections, detail, err := sess.ListVPNGatewayConnections(listvpnGWConnectionOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error reading list of VPN Gateway Connections:%s\n%s", err, detail)
 	}
+	availableVPNGatewayConnections.Connections, err = filterIBMIsResources(meta, filters, availableVPNGatewayConnections.Connections, func(instance vpcv1.VPNGatewayConnectionIntf) isFilterAttributes {
+		data := instance.(*vpcv1.VPNGatewayConnection)
+		return isFilterAttributes{Name: data.Name, LifecycleState: data.Status}
+	})
+	if err != nil {
+		return err
+	}
 	vpngatewayconnections := make([]map[string]interface{}, 0)
 	for _, instance := range availableVPNGatewayConnections.Connections {
 		gatewayconnection := map[string]interface{}{}
@@ -23,6 +23,7 @@ func DataSourceIBMISVPNGateways() *schema.Resource {
 		Read: dataSourceIBMVPNGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceIBMIsFilterSchema(isFilterName, isFilterTag, isFilterResourceGroup, isFilterLifecycleState),
 
 			isvpnGateways: {
 				Type:        schema.TypeList,
@@ -118,7 +119,15 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return err
+	}
+
 	listvpnGWOptions := sess.NewListVPNGatewaysOptions()
+	if resourceGroup, ok := filters.Value(isFilterResourceGroup); ok {
+		listvpnGWOptions.ResourceGroupID = &resourceGroup
+	}
 
 	start := ""
 	allrecs := []vpcv1.VPNGatewayIntf{}
@@ -136,6 +145,13 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 			break
 		}
 	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(instance vpcv1.VPNGatewayIntf) isFilterAttributes {
+		data := instance.(*vpcv1.VPNGateway)
+		return isFilterAttributes{CRN: data.CRN, Name: data.Name, ResourceGroup: data.ResourceGroup, LifecycleState: data.Status}
+	})
+	if err != nil {
+		return err
+	}
 
 	vpngateways := make([]map[string]interface{}, 0)
 	for _, instance := range allrecs {
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsVPNServerClient() *schema.Resource {
+	clientSchema := dataSourceIBMIsVPNServerClientSchema()
+	clientSchema["vpn_server"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The unique identifier of the VPN server.",
+	}
+	clientSchema["identifier"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The unique identifier of the VPN client.",
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNServerClientRead,
+		Schema:      clientSchema,
+	}
+}
+
+// dataSourceIBMIsVPNServerClientSchema returns the attributes of a VPN client,
+// shared by the ibm_is_vpn_server_client and ibm_is_vpn_server_clients data sources.
+func dataSourceIBMIsVPNServerClientSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"client_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address assigned to this VPN client from the client IP pool.",
+		},
+		"common_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The common name of the client certificate, if the client authenticated with a certificate.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time that the VPN client was created.",
+		},
+		"disconnected_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time that the VPN client was disconnected, if it is disconnected.",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL for this VPN client.",
+		},
+		"remote_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The remote IP address of this VPN client.",
+		},
+		"remote_port": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The remote port of this VPN client.",
+		},
+		"resource_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource type.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the VPN client: connected or disconnected.",
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The username that this VPN client provided when connecting, if the client authenticated with a username.",
+		},
+	}
+}
+
+func dataSourceIBMIsVPNServerClientRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get("vpn_server").(string)
+	getVPNServerClientOptions := &vpcv1.GetVPNServerClientOptions{}
+	getVPNServerClientOptions.SetVPNServerID(vpnServerID)
+	getVPNServerClientOptions.SetID(d.Get("identifier").(string))
+
+	vpnServerClient, response, err := vpcClient.GetVPNServerClientWithContext(context, getVPNServerClientOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "GetVPNServerClient", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", vpnServerID, *vpnServerClient.ID))
+	for k, v := range dataSourceIBMIsVPNServerClientToMap(*vpnServerClient) {
+		if k == "id" {
+			continue
+		}
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", k, err))
+		}
+	}
+	return nil
+}
+
+func dataSourceIBMIsVPNServerClientToMap(vpnServerClient vpcv1.VPNServerClient) map[string]interface{} {
+	clientMap := map[string]interface{}{}
+
+	if vpnServerClient.ClientIP != nil {
+		clientMap["client_ip"] = vpnServerClient.ClientIP.Address
+	}
+	if vpnServerClient.CommonName != nil {
+		clientMap["common_name"] = vpnServerClient.CommonName
+	}
+	if vpnServerClient.CreatedAt != nil {
+		clientMap["created_at"] = vpnServerClient.CreatedAt.String()
+	}
+	if vpnServerClient.DisconnectedAt != nil {
+		clientMap["disconnected_at"] = vpnServerClient.DisconnectedAt.String()
+	}
+	if vpnServerClient.Href != nil {
+		clientMap["href"] = vpnServerClient.Href
+	}
+	if vpnServerClient.ID != nil {
+		clientMap["id"] = vpnServerClient.ID
+	}
+	if vpnServerClient.RemoteIP != nil {
+		clientMap["remote_ip"] = vpnServerClient.RemoteIP.Address
+	}
+	if vpnServerClient.RemotePort != nil {
+		clientMap["remote_port"] = flex.IntValue(vpnServerClient.RemotePort)
+	}
+	if vpnServerClient.ResourceType != nil {
+		clientMap["resource_type"] = vpnServerClient.ResourceType
+	}
+	if vpnServerClient.Status != nil {
+		clientMap["status"] = vpnServerClient.Status
+	}
+	if vpnServerClient.Username != nil {
+		clientMap["username"] = vpnServerClient.Username
+	}
+
+	return clientMap
+}
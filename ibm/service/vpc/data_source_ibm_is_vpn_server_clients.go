@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsVPNServerClients() *schema.Resource {
+	clientSchema := dataSourceIBMIsVPNServerClientSchema()
+	clientSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier for this VPN client.",
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNServerClientsRead,
+
+		Schema: map[string]*schema.Schema{
+			// The clients have no name
+			"filter": dataSourceIBMIsFilterSchema(isFilterLifecycleState),
+			"vpn_server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the VPN server.",
+			},
+			"clients": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of VPN clients of the VPN server.",
+				Elem: &schema.Resource{
+					Schema: clientSchema,
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of VPN clients of the VPN server.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPNServerClientsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters, err := expandIBMIsDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listVPNServerClientsOptions := &vpcv1.ListVPNServerClientsOptions{}
+	listVPNServerClientsOptions.SetVPNServerID(d.Get("vpn_server").(string))
+	start := ""
+	allrecs := []vpcv1.VPNServerClient{}
+	for {
+		if start != "" {
+			listVPNServerClientsOptions.Start = &start
+		}
+		vpnServerClientCollection, response, err := vpcClient.ListVPNServerClientsWithContext(context, listVPNServerClientsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListVPNServerClientsWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "ListVPNServerClients", err, response)
+		}
+		start = flex.GetNext(vpnServerClientCollection.Next)
+		allrecs = append(allrecs, vpnServerClientCollection.Clients...)
+		if start == "" {
+			break
+		}
+	}
+	allrecs, err = filterIBMIsResources(meta, filters, allrecs, func(client vpcv1.VPNServerClient) isFilterAttributes {
+		return isFilterAttributes{LifecycleState: client.Status}
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clients := make([]map[string]interface{}, 0, len(allrecs))
+	for _, client := range allrecs {
+		clients = append(clients, dataSourceIBMIsVPNServerClientToMap(client))
+	}
+
+	d.SetId(dataSourceIBMIsVPNServerClientsID(d))
+	if err = d.Set("clients", clients); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting clients %s", err))
+	}
+	if err = d.Set("total_count", len(allrecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_count: %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMIsVPNServerClientsID returns a reasonable ID for the list.
+func dataSourceIBMIsVPNServerClientsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPNServerClientsDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpnclients-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpnclients-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnServerName := fmt.Sprintf("tfvpnclients-server-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNServerClientsDataSourceConfigBasic(vpcname, subnetname, vpnServerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_server_clients.is_vpn_server_clients", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_server_clients.is_vpn_server_clients", "total_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNServerClientsDataSourceConfigBasic(vpcname, subnetname, vpnServerName string) string {
+	return testAccCheckIBMIsVPNServerConfigBasic(vpcname, subnetname, vpnServerName, 600) + `
+	data "ibm_is_vpn_server_clients" "is_vpn_server_clients" {
+		vpn_server = ibm_is_vpn_server.is_vpn_server.id
+	}
+	`
+}
@@ -0,0 +1,771 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakevpc
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Default resource group of the resources created without one
+const (
+	DefaultResourceGroupID   = "fake-resource-group"
+	DefaultResourceGroupName = "Default"
+)
+
+func stringOr(v interface{}, fallback string) string {
+	if s, ok := v.(string); ok && s != "" {
+		return s
+	}
+	return fallback
+}
+
+func (s *Server) reference(collection, resourceType string, data map[string]interface{}) map[string]interface{} {
+	ref := map[string]interface{}{
+		"id":   data["id"],
+		"href": data["href"],
+		"name": data["name"],
+	}
+	if crn, ok := data["crn"]; ok {
+		ref["crn"] = crn
+	}
+	if resourceType != "" {
+		ref["resource_type"] = resourceType
+	}
+	return ref
+}
+
+func (s *Server) resourceGroup(body map[string]interface{}) map[string]interface{} {
+	id := DefaultResourceGroupID
+	if rg, ok := body["resource_group"].(map[string]interface{}); ok {
+		id = stringOr(rg["id"], id)
+	}
+	return map[string]interface{}{
+		"id":   id,
+		"name": DefaultResourceGroupName,
+		"href": fmt.Sprintf("https://resource-controller.cloud.ibm.com/v2/resource_groups/%s", id),
+	}
+}
+
+func (s *Server) zone(body map[string]interface{}) map[string]interface{} {
+	name := Region + "-1"
+	if zone, ok := body["zone"].(map[string]interface{}); ok {
+		name = stringOr(zone["name"], name)
+	}
+	return map[string]interface{}{
+		"name": name,
+		"href": fmt.Sprintf("%s/regions/%s/zones/%s", s.URL, Region, name),
+	}
+}
+
+// lookup resolves the identity {"id": ...} of a resource of the collection.
+func (s *Server) lookup(collection string, identity interface{}) (*object, bool) {
+	o, ok := s.objects[collection][refID(identity)]
+	return o, ok && !o.deleting
+}
+
+func badRequest(message string) (int, interface{}) {
+	return apiError(http.StatusBadRequest, "bad_request", message)
+}
+
+func (s *Server) create(collection string, body map[string]interface{}) (int, interface{}) {
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	switch collection {
+	case VPCs:
+		return s.createVPC(body)
+	case Subnets:
+		return s.createSubnet(body)
+	case SecurityGroups:
+		return s.createSecurityGroup(body)
+	case FloatingIPs:
+		return s.createFloatingIP(body)
+	case Volumes:
+		return s.createVolume(body)
+	case Instances:
+		return s.createInstance(body)
+	case LoadBalancers:
+		return s.createLoadBalancer(body)
+	}
+	return apiError(http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s can't be created", collection))
+}
+
+func (s *Server) createVPC(body map[string]interface{}) (int, interface{}) {
+	id := s.newID("vpc")
+	name := stringOr(body["name"], id)
+	vpc := map[string]interface{}{
+		"id":             id,
+		"crn":            s.crn("vpc", id),
+		"href":           s.href(VPCs, id),
+		"name":           name,
+		"classic_access": body["classic_access"] == true,
+		"created_at":     s.now(),
+		"resource_group": s.resourceGroup(body),
+		"cse_source_ips": []interface{}{
+			map[string]interface{}{
+				"ip":   map[string]interface{}{"address": "10.16.0.1"},
+				"zone": s.zone(nil),
+			},
+		},
+	}
+	vpcRef := s.reference(VPCs, "", vpc)
+
+	aclID := s.newID("network_acl")
+	acl := map[string]interface{}{
+		"id":             aclID,
+		"crn":            s.crn("network-acl", aclID),
+		"href":           s.href("network_acls", aclID),
+		"name":           name + "-default-acl",
+		"created_at":     s.now(),
+		"vpc":            vpcRef,
+		"resource_group": s.resourceGroup(body),
+		"subnets":        []interface{}{},
+		"rules":          []interface{}{},
+	}
+	s.add("network_acls", acl, "", "")
+
+	sg := s.newSecurityGroup(name+"-default-sg", vpcRef, body, nil)
+
+	rtID := s.newID("routing_table")
+	rt := map[string]interface{}{
+		"id":                            rtID,
+		"href":                          s.href("routing_tables", rtID),
+		"name":                          name + "-default-rt",
+		"created_at":                    s.now(),
+		"is_default":                    true,
+		"lifecycle_state":               "stable",
+		"resource_type":                 "routing_table",
+		"routes":                        []interface{}{},
+		"subnets":                       []interface{}{},
+		"route_direct_link_ingress":     false,
+		"route_transit_gateway_ingress": false,
+		"route_vpc_zone_ingress":        false,
+	}
+	s.add("routing_tables", rt, "", "")
+
+	vpc["default_network_acl"] = s.reference("network_acls", "", acl)
+	vpc["default_security_group"] = s.reference(SecurityGroups, "", sg)
+	vpc["default_routing_table"] = s.reference("routing_tables", "routing_table", rt)
+	s.add(VPCs, vpc, "pending", "available")
+	return http.StatusCreated, vpc
+}
+
+func (s *Server) createSubnet(body map[string]interface{}) (int, interface{}) {
+	vpc, ok := s.lookup(VPCs, body["vpc"])
+	if !ok {
+		return badRequest(fmt.Sprintf("VPC %s not found", refID(body["vpc"])))
+	}
+	id := s.newID("subnet")
+	total := 256
+	if v, ok := body["total_ipv4_address_count"].(float64); ok {
+		total = int(v)
+	}
+	cidr := stringOr(body["ipv4_cidr_block"], fmt.Sprintf("10.240.%d.0/24", s.counters["subnet"]))
+	acl := vpc.data["default_network_acl"]
+	if _, ok := s.lookup("network_acls", body["network_acl"]); ok {
+		acl = s.reference("network_acls", "", s.objects["network_acls"][refID(body["network_acl"])].data)
+	}
+	subnet := map[string]interface{}{
+		"id":                           id,
+		"crn":                          s.crn("subnet", id),
+		"href":                         s.href(Subnets, id),
+		"name":                         stringOr(body["name"], id),
+		"created_at":                   s.now(),
+		"ip_version":                   "ipv4",
+		"ipv4_cidr_block":              cidr,
+		"total_ipv4_address_count":     total,
+		"available_ipv4_address_count": total - 5,
+		"vpc":                          s.reference(VPCs, "", vpc.data),
+		"zone":                         s.zone(body),
+		"network_acl":                  acl,
+		"routing_table":                vpc.data["default_routing_table"],
+		"resource_group":               s.resourceGroup(body),
+	}
+	if gateway, ok := body["public_gateway"].(map[string]interface{}); ok {
+		subnet["public_gateway"] = map[string]interface{}{
+			"id":            gateway["id"],
+			"crn":           s.crn("public-gateway", fmt.Sprint(gateway["id"])),
+			"href":          s.href("public_gateways", fmt.Sprint(gateway["id"])),
+			"name":          gateway["id"],
+			"resource_type": "public_gateway",
+		}
+	}
+	s.add(Subnets, subnet, "pending", "available")
+	return http.StatusCreated, subnet
+}
+
+func (s *Server) newSecurityGroup(name string, vpcRef, body map[string]interface{}, rules []interface{}) map[string]interface{} {
+	id := s.newID("security_group")
+	sg := map[string]interface{}{
+		"id":                 id,
+		"crn":                s.crn("security-group", id),
+		"href":               s.href(SecurityGroups, id),
+		"name":               name,
+		"created_at":         s.now(),
+		"vpc":                vpcRef,
+		"resource_group":     s.resourceGroup(body),
+		"network_interfaces": []interface{}{},
+		"targets":            []interface{}{},
+		"rules":              []interface{}{},
+	}
+	for _, r := range rules {
+		if rule, ok := r.(map[string]interface{}); ok {
+			sg["rules"] = append(sg["rules"].([]interface{}), s.newRule(id, rule))
+		}
+	}
+	s.add(SecurityGroups, sg, "", "")
+	return sg
+}
+
+func (s *Server) createSecurityGroup(body map[string]interface{}) (int, interface{}) {
+	vpc, ok := s.lookup(VPCs, body["vpc"])
+	if !ok {
+		return badRequest(fmt.Sprintf("VPC %s not found", refID(body["vpc"])))
+	}
+	rules, _ := body["rules"].([]interface{})
+	sg := s.newSecurityGroup(stringOr(body["name"], ""), s.reference(VPCs, "", vpc.data), body, rules)
+	if sg["name"] == "" {
+		sg["name"] = sg["id"]
+	}
+	return http.StatusCreated, sg
+}
+
+// newRule builds a security group rule, the JSON document depends on the
+// protocol like the rules of the API.
+func (s *Server) newRule(sgID string, body map[string]interface{}) map[string]interface{} {
+	id := s.newID("security_group_rule")
+	rule := map[string]interface{}{
+		"id":         id,
+		"href":       fmt.Sprintf("%s/rules/%s", s.href(SecurityGroups, sgID), id),
+		"direction":  stringOr(body["direction"], "inbound"),
+		"ip_version": stringOr(body["ip_version"], "ipv4"),
+		"protocol":   stringOr(body["protocol"], "all"),
+		"remote":     s.ruleRemote(body["remote"]),
+	}
+	s.updateRule(rule, body)
+	return rule
+}
+
+func (s *Server) updateRule(rule, body map[string]interface{}) {
+	for _, field := range []string{"direction", "ip_version", "protocol"} {
+		if v, ok := body[field].(string); ok && v != "" {
+			rule[field] = v
+		}
+	}
+	if remote, ok := body["remote"]; ok {
+		rule["remote"] = s.ruleRemote(remote)
+	}
+	switch rule["protocol"] {
+	case "tcp", "udp":
+		for _, field := range []string{"port_min", "port_max"} {
+			if v, ok := body[field]; ok {
+				rule[field] = v
+			}
+		}
+		if _, ok := rule["port_min"]; !ok {
+			rule["port_min"] = 1
+		}
+		if _, ok := rule["port_max"]; !ok {
+			rule["port_max"] = 65535
+		}
+		delete(rule, "type")
+		delete(rule, "code")
+	case "icmp":
+		for _, field := range []string{"type", "code"} {
+			if v, ok := body[field]; ok {
+				rule[field] = v
+			}
+		}
+		delete(rule, "port_min")
+		delete(rule, "port_max")
+	default:
+		for _, field := range []string{"port_min", "port_max", "type", "code"} {
+			delete(rule, field)
+		}
+	}
+}
+
+func (s *Server) ruleRemote(v interface{}) map[string]interface{} {
+	remote, ok := v.(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"cidr_block": "0.0.0.0/0"}
+	}
+	if id := refID(remote); id != "" {
+		if sg, ok := s.objects[SecurityGroups][id]; ok {
+			return s.reference(SecurityGroups, "", sg.data)
+		}
+		return map[string]interface{}{"id": id}
+	}
+	if address, ok := remote["address"]; ok {
+		return map[string]interface{}{"address": address}
+	}
+	if cidr, ok := remote["cidr_block"]; ok {
+		return map[string]interface{}{"cidr_block": cidr}
+	}
+	return map[string]interface{}{"cidr_block": "0.0.0.0/0"}
+}
+
+func (s *Server) createFloatingIP(body map[string]interface{}) (int, interface{}) {
+	id := s.newID("floating_ip")
+	fip := map[string]interface{}{
+		"id":             id,
+		"crn":            s.crn("floating-ip", id),
+		"href":           s.href(FloatingIPs, id),
+		"name":           stringOr(body["name"], id),
+		"address":        fmt.Sprintf("169.48.0.%d", s.counters["floating_ip"]),
+		"created_at":     s.now(),
+		"zone":           s.zone(body),
+		"resource_group": s.resourceGroup(body),
+	}
+	if target, ok := body["target"].(map[string]interface{}); ok {
+		t := s.floatingIPTarget(target)
+		if t == nil {
+			return badRequest(fmt.Sprintf("Network interface %s not found", refID(target)))
+		}
+		fip["target"] = t
+		if zone, ok := t["zone"]; ok {
+			fip["zone"] = zone
+			delete(t, "zone")
+		}
+	}
+	s.add(FloatingIPs, fip, "pending", "available")
+	return http.StatusCreated, fip
+}
+
+// floatingIPTarget resolves a network interface identity to the target of a
+// floating IP, with the zone of its instance.
+func (s *Server) floatingIPTarget(identity map[string]interface{}) map[string]interface{} {
+	id := refID(identity)
+	for _, o := range s.objects[Instances] {
+		nics, _ := o.data["network_interfaces"].([]interface{})
+		for _, n := range nics {
+			nic := n.(map[string]interface{})
+			if nic["id"] == id {
+				return map[string]interface{}{
+					"id":                   nic["id"],
+					"href":                 nic["href"],
+					"name":                 nic["name"],
+					"primary_ipv4_address": nic["primary_ipv4_address"],
+					"resource_type":        "network_interface",
+					"zone":                 o.data["zone"],
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) newVolume(body map[string]interface{}, zone map[string]interface{}) map[string]interface{} {
+	id := s.newID("volume")
+	capacity := 100
+	if v, ok := body["capacity"].(float64); ok {
+		capacity = int(v)
+	}
+	profile := "general-purpose"
+	if p, ok := body["profile"].(map[string]interface{}); ok {
+		profile = stringOr(p["name"], profile)
+	}
+	iops := 3 * capacity
+	if v, ok := body["iops"].(float64); ok {
+		iops = int(v)
+	}
+	return map[string]interface{}{
+		"id":                 id,
+		"crn":                s.crn("volume", id),
+		"href":               s.href(Volumes, id),
+		"name":               stringOr(body["name"], id),
+		"capacity":           capacity,
+		"iops":               iops,
+		"bandwidth":          393,
+		"encryption":         "provider_managed",
+		"created_at":         s.now(),
+		"profile":            map[string]interface{}{"name": profile, "href": fmt.Sprintf("%s/volume/profiles/%s", s.URL, profile)},
+		"zone":               zone,
+		"resource_group":     s.resourceGroup(body),
+		"status_reasons":     []interface{}{},
+		"volume_attachments": []interface{}{},
+	}
+}
+
+func (s *Server) createVolume(body map[string]interface{}) (int, interface{}) {
+	volume := s.newVolume(body, s.zone(body))
+	s.add(Volumes, volume, "pending", "available")
+	return http.StatusCreated, volume
+}
+
+func (s *Server) createInstance(body map[string]interface{}) (int, interface{}) {
+	vpc, ok := s.lookup(VPCs, body["vpc"])
+	if !ok {
+		return badRequest(fmt.Sprintf("VPC %s not found", refID(body["vpc"])))
+	}
+	primary, _ := body["primary_network_interface"].(map[string]interface{})
+	subnet, ok := s.lookup(Subnets, primary["subnet"])
+	if !ok {
+		return badRequest(fmt.Sprintf("Subnet %s not found", refID(primary["subnet"])))
+	}
+
+	id := s.newID("instance")
+	zone := s.zone(body)
+	profile := "bx2-2x8"
+	if p, ok := body["profile"].(map[string]interface{}); ok {
+		profile = stringOr(p["name"], profile)
+	}
+
+	nicID := s.newID("network_interface")
+	address := fmt.Sprintf("10.240.0.%d", s.counters["network_interface"]+3)
+	securityGroups := []interface{}{vpc.data["default_security_group"]}
+	if groups, ok := primary["security_groups"].([]interface{}); ok && len(groups) > 0 {
+		securityGroups = []interface{}{}
+		for _, g := range groups {
+			if sg, ok := s.lookup(SecurityGroups, g); ok {
+				securityGroups = append(securityGroups, s.reference(SecurityGroups, "", sg.data))
+			}
+		}
+	}
+	nic := map[string]interface{}{
+		"id":                   nicID,
+		"href":                 fmt.Sprintf("%s/network_interfaces/%s", s.href(Instances, id), nicID),
+		"name":                 stringOr(primary["name"], "eth0"),
+		"primary_ipv4_address": address,
+		"primary_ip":           map[string]interface{}{"address": address},
+		"subnet":               s.reference(Subnets, "", subnet.data),
+		"security_groups":      securityGroups,
+		"floating_ips":         []interface{}{},
+		"allow_ip_spoofing":    primary["allow_ip_spoofing"] == true,
+		"port_speed":           1000,
+		"status":               "available",
+		"type":                 "primary",
+		"created_at":           s.now(),
+		"resource_type":        "network_interface",
+	}
+
+	bootBody := map[string]interface{}{"name": id + "-boot"}
+	if attachment, ok := body["boot_volume_attachment"].(map[string]interface{}); ok {
+		if volume, ok := attachment["volume"].(map[string]interface{}); ok {
+			bootBody = volume
+		}
+	}
+	bootVolume := s.newVolume(bootBody, zone)
+	if _, ok := bootBody["capacity"]; !ok {
+		bootVolume["capacity"] = 100
+	}
+	attachmentID := s.newID("volume_attachment")
+	bootAttachment := map[string]interface{}{
+		"id":     attachmentID,
+		"href":   fmt.Sprintf("%s/volume_attachments/%s", s.href(Instances, id), attachmentID),
+		"name":   id + "-boot-attachment",
+		"device": map[string]interface{}{"id": attachmentID + "-device"},
+		"volume": s.reference(Volumes, "", bootVolume),
+	}
+	bootVolume["volume_attachments"] = []interface{}{
+		map[string]interface{}{
+			"id":       attachmentID,
+			"href":     bootAttachment["href"],
+			"name":     bootAttachment["name"],
+			"type":     "boot",
+			"instance": map[string]interface{}{"id": id, "crn": s.crn("instance", id), "href": s.href(Instances, id), "name": stringOr(body["name"], id)},
+		},
+	}
+	s.add(Volumes, bootVolume, "", "available")
+
+	image := map[string]interface{}{"id": "fake-image", "name": "fake-image"}
+	if i, ok := body["image"].(map[string]interface{}); ok {
+		image = map[string]interface{}{"id": stringOr(i["id"], "fake-image"), "name": stringOr(i["id"], "fake-image")}
+	}
+	image["crn"] = s.crn("image", fmt.Sprint(image["id"]))
+	image["href"] = s.href("images", fmt.Sprint(image["id"]))
+
+	instance := map[string]interface{}{
+		"id":                        id,
+		"crn":                       s.crn("instance", id),
+		"href":                      s.href(Instances, id),
+		"name":                      stringOr(body["name"], id),
+		"created_at":                s.now(),
+		"profile":                   map[string]interface{}{"name": profile, "href": fmt.Sprintf("%s/instance/profiles/%s", s.URL, profile)},
+		"vpc":                       s.reference(VPCs, "", vpc.data),
+		"zone":                      zone,
+		"image":                     image,
+		"memory":                    8,
+		"vcpu":                      map[string]interface{}{"architecture": "amd64", "count": 2},
+		"bandwidth":                 4000,
+		"total_network_bandwidth":   3000,
+		"total_volume_bandwidth":    1000,
+		"startable":                 true,
+		"primary_network_interface": nic,
+		"network_interfaces":        []interface{}{nic},
+		"boot_volume_attachment":    bootAttachment,
+		"volume_attachments":        []interface{}{bootAttachment},
+		"resource_group":            s.resourceGroup(body),
+		"disks":                     []interface{}{},
+		"status_reasons":            []interface{}{},
+	}
+	o := s.add(Instances, instance, "pending", "running")
+	o.keys, _ = body["keys"].([]interface{})
+	return http.StatusCreated, instance
+}
+
+// Status an instance goes through, and ends in, per action type
+var instanceActions = map[string][2]string{
+	"start":  {"starting", "running"},
+	"stop":   {"stopping", "stopped"},
+	"reboot": {"restarting", "running"},
+}
+
+func (s *Server) routeSubresource(r *http.Request, collection, id string, segments []string, body map[string]interface{}) (int, interface{}) {
+	o, ok := s.objects[collection][id]
+	if !ok || o.deleting {
+		return notFound(collection, id)
+	}
+	switch {
+	case collection == SecurityGroups && segments[0] == "rules":
+		return s.routeRules(r, o, segments[1:], body)
+	case collection == "network_acls" && segments[0] == "rules":
+		return s.routeNetworkACLRules(r, o, segments[1:], body)
+	case collection == VPCs && len(segments) == 1 && segments[0] == "routing_tables" && r.Method == http.MethodGet:
+		// The fake only creates the default routing tables
+		routingTables := []interface{}{}
+		if rt, ok := s.objects["routing_tables"][refID(o.data["default_routing_table"])]; ok {
+			routingTables = append(routingTables, rt.data)
+		}
+		return http.StatusOK, map[string]interface{}{
+			"routing_tables": routingTables,
+			"first":          map[string]interface{}{"href": s.href(VPCs, id) + "/routing_tables?limit=50"},
+			"limit":          50,
+			"total_count":    len(routingTables),
+		}
+	case collection == Instances && segments[0] == "actions" && r.Method == http.MethodPost:
+		transition, ok := instanceActions[stringOr(body["type"], "")]
+		if !ok {
+			return badRequest(fmt.Sprintf("Unsupported action %v", body["type"]))
+		}
+		o.finalStatus = transition[1]
+		o.data["status"] = transition[1]
+		if reads := s.pendingReads[Instances]; reads != 0 {
+			o.data["status"] = transition[0]
+			o.pending = true
+			o.readsLeft = reads
+		}
+		actionID := s.newID("instance_action")
+		return http.StatusCreated, map[string]interface{}{
+			"id":         actionID,
+			"href":       fmt.Sprintf("%s/actions/%s", s.href(Instances, id), actionID),
+			"type":       body["type"],
+			"status":     "pending",
+			"created_at": s.now(),
+		}
+	case collection == Instances && segments[0] == "initialization" && r.Method == http.MethodGet:
+		keys := []interface{}{}
+		for _, k := range o.keys {
+			keys = append(keys, map[string]interface{}{"id": refID(k), "name": refID(k), "crn": s.crn("key", refID(k)), "href": s.href("keys", refID(k))})
+		}
+		return http.StatusOK, map[string]interface{}{"keys": keys, "user_accounts": []interface{}{}}
+	case collection == LoadBalancers && segments[0] == "pools":
+		return s.routePools(r, o, segments[1:], body)
+	case collection == Instances && (segments[0] == "network_interfaces" || segments[0] == "volume_attachments") && r.Method == http.MethodGet:
+		items, _ := o.data[segments[0]].([]interface{})
+		if len(segments) == 1 {
+			return http.StatusOK, map[string]interface{}{segments[0]: items}
+		}
+		for _, item := range items {
+			if m := item.(map[string]interface{}); m["id"] == segments[1] {
+				return http.StatusOK, m
+			}
+		}
+		return notFound(segments[0], segments[1])
+	}
+	return apiError(http.StatusNotFound, "not_found", fmt.Sprintf("Unsupported path %s", r.URL.Path))
+}
+
+// routeNetworkACLRules lists the ordered rules of a network ACL, and creates
+// rules before the rule of their before property, or after all the rules.
+func (s *Server) routeNetworkACLRules(r *http.Request, acl *object, segments []string, body map[string]interface{}) (int, interface{}) {
+	rules, _ := acl.data["rules"].([]interface{})
+	if len(segments) != 0 {
+		return apiError(http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not supported on %s", r.Method, r.URL.Path))
+	}
+	switch r.Method {
+	case http.MethodGet:
+		return http.StatusOK, map[string]interface{}{
+			"rules":       rules,
+			"first":       map[string]interface{}{"href": s.href("network_acls", acl.data["id"].(string)) + "/rules?limit=50"},
+			"limit":       50,
+			"total_count": len(rules),
+		}
+	case http.MethodPost:
+		id := s.newID("network_acl_rule")
+		rule := map[string]interface{}{}
+		for k, v := range body {
+			if k != "before" {
+				rule[k] = v
+			}
+		}
+		rule["id"] = id
+		rule["href"] = fmt.Sprintf("%s/rules/%s", s.href("network_acls", acl.data["id"].(string)), id)
+		rule["name"] = stringOr(body["name"], id)
+		rule["ip_version"] = "ipv4"
+		rule["created_at"] = s.now()
+		position := len(rules)
+		for i, item := range rules {
+			if before := refID(body["before"]); before != "" && item.(map[string]interface{})["id"] == before {
+				position = i
+			}
+		}
+		acl.data["rules"] = append(rules[:position:position], append([]interface{}{rule}, rules[position:]...)...)
+		return http.StatusCreated, rule
+	}
+	return apiError(http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not supported on %s", r.Method, r.URL.Path))
+}
+
+func (s *Server) routeRules(r *http.Request, sg *object, segments []string, body map[string]interface{}) (int, interface{}) {
+	rules, _ := sg.data["rules"].([]interface{})
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			return http.StatusOK, map[string]interface{}{"rules": rules}
+		case http.MethodPost:
+			rule := s.newRule(sg.data["id"].(string), body)
+			sg.data["rules"] = append(rules, rule)
+			return http.StatusCreated, rule
+		}
+	}
+	for i, item := range rules {
+		rule := item.(map[string]interface{})
+		if rule["id"] != segments[0] {
+			continue
+		}
+		switch r.Method {
+		case http.MethodGet:
+			return http.StatusOK, rule
+		case http.MethodPatch:
+			s.updateRule(rule, body)
+			return http.StatusOK, rule
+		case http.MethodDelete:
+			sg.data["rules"] = append(rules[:i:i], rules[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return notFound("rules", segments[0])
+}
+
+// createLoadBalancer creates an active load balancer and its pools. The
+// listeners and the members of the pool prototypes are ignored.
+func (s *Server) createLoadBalancer(body map[string]interface{}) (int, interface{}) {
+	subnets := []interface{}{}
+	identities, _ := body["subnets"].([]interface{})
+	for _, identity := range identities {
+		subnet, ok := s.lookup(Subnets, identity)
+		if !ok {
+			return badRequest(fmt.Sprintf("Subnet %s not found", refID(identity)))
+		}
+		subnets = append(subnets, s.reference(Subnets, "", subnet.data))
+	}
+	if len(subnets) == 0 {
+		return badRequest("A load balancer needs a subnet")
+	}
+	id := s.newID("load_balancer")
+	lb := map[string]interface{}{
+		"id":                  id,
+		"crn":                 s.crn("load-balancer", id),
+		"href":                s.href(LoadBalancers, id),
+		"name":                stringOr(body["name"], id),
+		"created_at":          s.now(),
+		"hostname":            id + ".lb.appdomain.cloud",
+		"is_public":           body["is_public"] == true,
+		"operating_status":    "online",
+		"provisioning_status": "active",
+		"profile":             map[string]interface{}{"family": "application", "name": "dynamic"},
+		"resource_group":      s.resourceGroup(body),
+		"subnets":             subnets,
+		"listeners":           []interface{}{},
+		"pools":               []interface{}{},
+		"private_ips":         []interface{}{},
+		"public_ips":          []interface{}{},
+	}
+	o := s.add(LoadBalancers, lb, "", "")
+	o.members = map[string][]interface{}{}
+	prototypes, _ := body["pools"].([]interface{})
+	for _, p := range prototypes {
+		prototype, _ := p.(map[string]interface{})
+		poolID := s.newID("load_balancer_pool")
+		lb["pools"] = append(lb["pools"].([]interface{}), map[string]interface{}{
+			"id":                  poolID,
+			"href":                fmt.Sprintf("%s/pools/%s", s.href(LoadBalancers, id), poolID),
+			"name":                stringOr(prototype["name"], poolID),
+			"algorithm":           stringOr(prototype["algorithm"], "round_robin"),
+			"protocol":            stringOr(prototype["protocol"], "http"),
+			"health_monitor":      prototype["health_monitor"],
+			"created_at":          s.now(),
+			"provisioning_status": "active",
+		})
+		o.members[poolID] = []interface{}{}
+	}
+	return http.StatusCreated, lb
+}
+
+// routePools reads the pools of a load balancer, and lists and replaces the
+// members of a pool.
+func (s *Server) routePools(r *http.Request, lb *object, segments []string, body map[string]interface{}) (int, interface{}) {
+	pools, _ := lb.data["pools"].([]interface{})
+	if len(segments) == 0 && r.Method == http.MethodGet {
+		items := []interface{}{}
+		for _, pool := range pools {
+			items = append(items, s.pool(lb, pool.(map[string]interface{})))
+		}
+		return http.StatusOK, map[string]interface{}{"pools": items}
+	}
+	for _, p := range pools {
+		pool := p.(map[string]interface{})
+		if len(segments) == 0 || pool["id"] != segments[0] {
+			continue
+		}
+		poolID := segments[0]
+		switch {
+		case len(segments) == 1 && r.Method == http.MethodGet:
+			return http.StatusOK, s.pool(lb, pool)
+		case len(segments) == 2 && segments[1] == "members" && r.Method == http.MethodGet:
+			return http.StatusOK, map[string]interface{}{"members": lb.members[poolID]}
+		case len(segments) == 2 && segments[1] == "members" && r.Method == http.MethodPut:
+			members := []interface{}{}
+			prototypes, _ := body["members"].([]interface{})
+			for _, m := range prototypes {
+				prototype, _ := m.(map[string]interface{})
+				memberID := s.newID("load_balancer_pool_member")
+				weight := 50.0
+				if v, ok := prototype["weight"].(float64); ok {
+					weight = v
+				}
+				members = append(members, map[string]interface{}{
+					"id":                  memberID,
+					"href":                fmt.Sprintf("%s/pools/%s/members/%s", s.href(LoadBalancers, lb.data["id"].(string)), poolID, memberID),
+					"port":                prototype["port"],
+					"target":              prototype["target"],
+					"weight":              weight,
+					"health":              "ok",
+					"created_at":          s.now(),
+					"provisioning_status": "active",
+				})
+			}
+			lb.members[poolID] = members
+			return http.StatusAccepted, map[string]interface{}{"members": members}
+		}
+		break
+	}
+	return apiError(http.StatusNotFound, "not_found", fmt.Sprintf("Unsupported path %s", r.URL.Path))
+}
+
+// pool returns the pool document, with references to its members.
+func (s *Server) pool(lb *object, pool map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for k, v := range pool {
+		result[k] = v
+	}
+	members := []interface{}{}
+	for _, m := range lb.members[pool["id"].(string)] {
+		member := m.(map[string]interface{})
+		members = append(members, map[string]interface{}{"id": member["id"], "href": member["href"]})
+	}
+	result["members"] = members
+	return result
+}
@@ -0,0 +1,477 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package fakevpc is an in-process stand-in for the core of the VPC v1 REST
+// API: VPCs and their routing tables, subnets, security groups and their
+// rules, network ACL rules, floating IPs, volumes, instances and load
+// balancers with their pools and pool members. Public gateways and endpoint
+// gateways are listed, always empty. It lets the VPC resources be tested
+// without IBM Cloud.
+//
+// Resources are created in their pending state and become available, or
+// running, after a configurable number of reads, so that the provider state
+// waiters are exercised. Failures are injected per method and path.
+package fakevpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// Defaults of the fake
+const (
+	Region    = "us-south"
+	AccountID = "fakeaccount"
+)
+
+// Collections served by the fake, as they appear in the API paths
+const (
+	VPCs           = "vpcs"
+	Subnets        = "subnets"
+	SecurityGroups = "security_groups"
+	FloatingIPs    = "floating_ips"
+	Volumes        = "volumes"
+	Instances      = "instances"
+	LoadBalancers  = "load_balancers"
+)
+
+// Server is a fake VPC API listening on a local port.
+type Server struct {
+	// URL of the API, ending with /v1
+	URL string
+
+	server *httptest.Server
+
+	mu       sync.Mutex
+	objects  map[string]map[string]*object
+	counters map[string]int
+	// Reads a resource stays pending, or deleting, per collection
+	pendingReads map[string]int
+	failures     []*failure
+	requests     []string
+	clock        time.Time
+}
+
+// object is a resource of a collection, the JSON document returned by the API
+// and its lifecycle.
+type object struct {
+	data map[string]interface{}
+	// The object is in a transitional status, it moves to finalStatus, or
+	// is removed when deleting, once it has been read readsLeft more times. A
+	// negative readsLeft never leaves it.
+	pending     bool
+	readsLeft   int
+	finalStatus string
+	deleting    bool
+	// Keys of an instance, returned by its initialization
+	keys []interface{}
+	// Members of the pools of a load balancer, by pool ID
+	members map[string][]interface{}
+}
+
+type failure struct {
+	method     string
+	path       string
+	statusCode int
+	code       string
+	remaining  int
+}
+
+// NewServer starts a fake VPC API. Close it at the end of the test.
+func NewServer() *Server {
+	s := &Server{
+		objects:      map[string]map[string]*object{},
+		counters:     map[string]int{},
+		pendingReads: map[string]int{},
+		clock:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, collection := range []string{VPCs, Subnets, SecurityGroups, FloatingIPs, Volumes, Instances, LoadBalancers, "network_acls", "routing_tables", "public_gateways", "endpoint_gateways"} {
+		s.objects[collection] = map[string]*object{}
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL + "/v1"
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// ClientSession returns a provider meta whose VPC client sends its requests to
+// the fake.
+func (s *Server) ClientSession() conns.ClientSession {
+	return conns.NewOfflineClientSession(&conns.Config{
+		Region: Region,
+		Endpoints: map[string]string{
+			"IBMCLOUD_IS_NG_API_ENDPOINT": s.URL,
+		},
+	}, AccountID)
+}
+
+// SetPendingReads sets how many reads the resources of the collection created
+// or deleted from now on stay pending, or deleting. A negative value keeps
+// them pending forever. Resources are available on creation by default.
+func (s *Server) SetPendingReads(collection string, reads int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pendingReads[collection] = reads
+}
+
+// Fail makes the next count requests with the given method and path, relative
+// to the URL of the server, fail with statusCode. A negative count fails all
+// of them.
+func (s *Server) Fail(method, path string, statusCode, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	code := "internal_error"
+	switch statusCode {
+	case http.StatusNotFound:
+		code = "not_found"
+	case http.StatusConflict:
+		code = "conflict"
+	case http.StatusTooManyRequests:
+		code = "too_many_requests"
+	case http.StatusBadRequest:
+		code = "bad_request"
+	}
+	s.failures = append(s.failures, &failure{method: method, path: path, statusCode: statusCode, code: code, remaining: count})
+}
+
+// Requests returns the requests served so far as "METHOD path".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Get returns the JSON document of a resource, or nil when it doesn't exist.
+// It doesn't count as a read of the resource.
+func (s *Server) Get(collection, id string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if o, ok := s.objects[collection][id]; ok {
+		return o.data
+	}
+	return nil
+}
+
+// IDs returns the IDs of the resources of the collection, sorted.
+func (s *Server) IDs(collection string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]string, 0, len(s.objects[collection]))
+	for id := range s.objects[collection] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// apiError is the error document of the VPC API
+func apiError(statusCode int, code, message string) (int, interface{}) {
+	return statusCode, map[string]interface{}{
+		"errors": []interface{}{
+			map[string]interface{}{"code": code, "message": message},
+		},
+		"trace": fmt.Sprintf("fake-trace-%d", statusCode),
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	path := strings.TrimPrefix(r.URL.Path, "/v1")
+	s.requests = append(s.requests, r.Method+" "+path)
+	requestID := fmt.Sprintf("fake-%d", len(s.requests))
+
+	var body map[string]interface{}
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPatch || r.Method == http.MethodPut) {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+	var statusCode int
+	var result interface{}
+	if f := s.failure(r.Method, path); f != nil {
+		statusCode, result = apiError(f.statusCode, f.code, fmt.Sprintf("Injected failure of %s %s", r.Method, path))
+	} else {
+		statusCode, result = s.route(r, strings.Split(strings.Trim(path, "/"), "/"), body)
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("X-Request-Id", requestID)
+	if result == nil {
+		w.WriteHeader(statusCode)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(result)
+}
+
+func (s *Server) failure(method, path string) *failure {
+	for _, f := range s.failures {
+		if f.remaining != 0 && f.method == method && f.path == path {
+			if f.remaining > 0 {
+				f.remaining--
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) route(r *http.Request, segments []string, body map[string]interface{}) (int, interface{}) {
+	collection := segments[0]
+	if _, ok := s.objects[collection]; !ok {
+		return apiError(http.StatusNotFound, "not_found", fmt.Sprintf("Unsupported path %s", r.URL.Path))
+	}
+	switch len(segments) {
+	case 1:
+		switch r.Method {
+		case http.MethodGet:
+			return s.list(collection, r)
+		case http.MethodPost:
+			return s.create(collection, body)
+		}
+	case 2:
+		switch r.Method {
+		case http.MethodGet:
+			return s.read(collection, segments[1])
+		case http.MethodPatch:
+			return s.update(collection, segments[1], body)
+		case http.MethodDelete:
+			return s.delete(collection, segments[1])
+		}
+	default:
+		return s.routeSubresource(r, collection, segments[1], segments[2:], body)
+	}
+	return apiError(http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not supported on %s", r.Method, r.URL.Path))
+}
+
+// newID returns a deterministic ID for the next resource of the kind.
+func (s *Server) newID(kind string) string {
+	s.counters[kind]++
+	return fmt.Sprintf("r006-fake-%s-%04d", strings.Replace(kind, "_", "-", -1), s.counters[kind])
+}
+
+// now returns a deterministic timestamp, a second after the previous one.
+func (s *Server) now() string {
+	s.clock = s.clock.Add(time.Second)
+	return s.clock.Format(time.RFC3339)
+}
+
+func (s *Server) href(collection, id string) string {
+	return fmt.Sprintf("%s/%s/%s", s.URL, collection, id)
+}
+
+func (s *Server) crn(resourceType, id string) string {
+	return fmt.Sprintf("crn:v1:bluemix:public:is:%s:a/%s::%s:%s", Region, AccountID, resourceType, id)
+}
+
+// add stores a new resource, pending when the collection is configured so.
+func (s *Server) add(collection string, data map[string]interface{}, pendingStatus, finalStatus string) *object {
+	o := &object{data: data, finalStatus: finalStatus}
+	if reads := s.pendingReads[collection]; reads != 0 && finalStatus != "" {
+		o.pending = true
+		o.readsLeft = reads
+		data["status"] = pendingStatus
+	} else if finalStatus != "" {
+		data["status"] = finalStatus
+	}
+	s.objects[collection][data["id"].(string)] = o
+	return o
+}
+
+// touch counts a read of the resource and moves it along its lifecycle. It
+// returns false when the resource doesn't exist, or is gone once deleted.
+func (s *Server) touch(collection, id string) bool {
+	o, ok := s.objects[collection][id]
+	if !ok {
+		return false
+	}
+	switch {
+	case o.pending && o.readsLeft == 0:
+		o.pending = false
+		if o.deleting {
+			s.remove(collection, id)
+			return false
+		}
+		o.data["status"] = o.finalStatus
+	case o.readsLeft > 0:
+		o.readsLeft--
+	}
+	return true
+}
+
+func (s *Server) list(collection string, r *http.Request) (int, interface{}) {
+	ids := make([]string, 0, len(s.objects[collection]))
+	for id := range s.objects[collection] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	query := r.URL.Query()
+	items := []interface{}{}
+	for _, id := range ids {
+		if !s.touch(collection, id) {
+			continue
+		}
+		data := s.objects[collection][id].data
+		if matchesFilters(data, query) {
+			items = append(items, data)
+		}
+	}
+	return http.StatusOK, map[string]interface{}{
+		collection:    items,
+		"first":       map[string]interface{}{"href": fmt.Sprintf("%s/%s?limit=50", s.URL, collection)},
+		"limit":       50,
+		"total_count": len(items),
+	}
+}
+
+// matchesFilters applies the name, vpc.id, vpc.crn, zone.name and
+// resource_group.id filters of the list operations.
+func matchesFilters(data map[string]interface{}, query map[string][]string) bool {
+	for key, values := range query {
+		switch key {
+		case "name", "vpc.id", "vpc.crn", "vpc.name", "zone.name", "resource_group.id":
+		default:
+			continue
+		}
+		var v interface{} = data
+		for _, field := range strings.Split(key, ".") {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				v = nil
+				break
+			}
+			v = m[field]
+		}
+		if fmt.Sprint(v) != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) read(collection, id string) (int, interface{}) {
+	if !s.touch(collection, id) {
+		return notFound(collection, id)
+	}
+	return http.StatusOK, s.objects[collection][id].data
+}
+
+func notFound(collection, id string) (int, interface{}) {
+	return apiError(http.StatusNotFound, "not_found", fmt.Sprintf("%s %s not found", strings.TrimSuffix(collection, "s"), id))
+}
+
+// Fields of the resources that can be updated
+var updatableFields = map[string][]string{
+	VPCs:             {"name"},
+	Subnets:          {"name", "network_acl", "public_gateway", "routing_table"},
+	SecurityGroups:   {"name"},
+	FloatingIPs:      {"name", "target"},
+	Volumes:          {"name", "capacity", "iops", "profile"},
+	Instances:        {"name", "profile", "total_volume_bandwidth"},
+	"network_acls":   {"name"},
+	"routing_tables": {"name"},
+}
+
+func (s *Server) update(collection, id string, body map[string]interface{}) (int, interface{}) {
+	o, ok := s.objects[collection][id]
+	if !ok || o.deleting {
+		return notFound(collection, id)
+	}
+	for _, field := range updatableFields[collection] {
+		if v, ok := body[field]; ok {
+			o.data[field] = v
+		}
+	}
+	if collection == FloatingIPs {
+		if target, ok := body["target"].(map[string]interface{}); ok {
+			o.data["target"] = s.floatingIPTarget(target)
+		}
+	}
+	return http.StatusOK, o.data
+}
+
+func (s *Server) delete(collection, id string) (int, interface{}) {
+	o, ok := s.objects[collection][id]
+	if !ok || o.deleting {
+		return notFound(collection, id)
+	}
+	if status, result := s.checkInUse(collection, id); status != 0 {
+		return status, result
+	}
+	reads := s.pendingReads[collection]
+	if reads == 0 || o.finalStatus == "" {
+		s.remove(collection, id)
+		return http.StatusNoContent, nil
+	}
+	o.deleting = true
+	o.pending = true
+	o.readsLeft = reads
+	o.data["status"] = "deleting"
+	return http.StatusNoContent, nil
+}
+
+// remove deletes the resource and the ones it owns.
+func (s *Server) remove(collection, id string) {
+	o := s.objects[collection][id]
+	delete(s.objects[collection], id)
+	switch collection {
+	case VPCs:
+		for _, owned := range []string{"default_network_acl", "default_security_group", "default_routing_table"} {
+			if ref, ok := o.data[owned].(map[string]interface{}); ok {
+				delete(s.objects["network_acls"], fmt.Sprint(ref["id"]))
+				delete(s.objects[SecurityGroups], fmt.Sprint(ref["id"]))
+				delete(s.objects["routing_tables"], fmt.Sprint(ref["id"]))
+			}
+		}
+	case Instances:
+		if attachment, ok := o.data["boot_volume_attachment"].(map[string]interface{}); ok {
+			if volume, ok := attachment["volume"].(map[string]interface{}); ok {
+				delete(s.objects[Volumes], fmt.Sprint(volume["id"]))
+			}
+		}
+	}
+}
+
+// checkInUse refuses to delete a VPC with subnets or a subnet with
+// instances, like the API does.
+func (s *Server) checkInUse(collection, id string) (int, interface{}) {
+	dependents := map[string]string{VPCs: Subnets, Subnets: Instances}
+	dependent, ok := dependents[collection]
+	if !ok {
+		return 0, nil
+	}
+	for _, o := range s.objects[dependent] {
+		switch collection {
+		case VPCs:
+			if refID(o.data["vpc"]) == id {
+				return apiError(http.StatusConflict, "vpc_in_use", fmt.Sprintf("VPC %s still has subnets", id))
+			}
+		case Subnets:
+			if nic, ok := o.data["primary_network_interface"].(map[string]interface{}); ok && refID(nic["subnet"]) == id {
+				return apiError(http.StatusConflict, "subnet_in_use", fmt.Sprintf("Subnet %s still has instances", id))
+			}
+		}
+	}
+	return 0, nil
+}
+
+func refID(ref interface{}) string {
+	if m, ok := ref.(map[string]interface{}); ok {
+		if id, ok := m["id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fakevpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func call(t *testing.T, s *Server, method, path string, body interface{}) (int, map[string]interface{}) {
+	t.Helper()
+	var reader *bytes.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	req, err := http.NewRequest(method, s.URL+path+separator+"version=2021-01-01&generation=2", reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestServerLifecycle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.SetPendingReads(VPCs, 2)
+	s.SetPendingReads(Instances, 1)
+
+	status, vpc := call(t, s, "POST", "/vpcs", map[string]interface{}{"name": "vpc1"})
+	if status != http.StatusCreated || vpc["status"] != "pending" {
+		t.Fatalf("Unexpected create response %d %v", status, vpc)
+	}
+	vpcID := vpc["id"].(string)
+	for _, expected := range []string{"pending", "pending", "available", "available"} {
+		if _, v := call(t, s, "GET", "/vpcs/"+vpcID, nil); v["status"] != expected {
+			t.Errorf("Expected the VPC to be %s, got %v", expected, v["status"])
+		}
+	}
+	sgID := refID(vpc["default_security_group"])
+	if s.Get(SecurityGroups, sgID) == nil {
+		t.Errorf("Expected the default security group %s to exist", sgID)
+	}
+
+	_, subnet := call(t, s, "POST", "/subnets", map[string]interface{}{"vpc": map[string]interface{}{"id": vpcID}, "zone": map[string]interface{}{"name": "us-south-1"}, "total_ipv4_address_count": 256})
+	subnetID := subnet["id"].(string)
+	if subnet["available_ipv4_address_count"] != float64(251) {
+		t.Errorf("Unexpected subnet %v", subnet)
+	}
+
+	if status, _ := call(t, s, "DELETE", "/vpcs/"+vpcID, nil); status != http.StatusConflict {
+		t.Errorf("Expected a VPC with subnets not to be deleted, got %d", status)
+	}
+
+	_, rule := call(t, s, "POST", "/security_groups/"+sgID+"/rules", map[string]interface{}{"direction": "inbound", "protocol": "tcp", "port_min": 22, "port_max": 22})
+	if rule["port_min"] != float64(22) || rule["remote"].(map[string]interface{})["cidr_block"] != "0.0.0.0/0" {
+		t.Errorf("Unexpected rule %v", rule)
+	}
+	_, rule = call(t, s, "PATCH", "/security_groups/"+sgID+"/rules/"+rule["id"].(string), map[string]interface{}{"protocol": "icmp", "type": 8})
+	if _, ok := rule["port_min"]; ok || rule["type"] != float64(8) {
+		t.Errorf("Expected the rule to become an ICMP rule, got %v", rule)
+	}
+	if _, rules := call(t, s, "GET", "/security_groups/"+sgID+"/rules", nil); len(rules["rules"].([]interface{})) != 1 {
+		t.Errorf("Expected one rule, got %v", rules)
+	}
+
+	_, instance := call(t, s, "POST", "/instances", map[string]interface{}{
+		"name":                      "vsi1",
+		"vpc":                       map[string]interface{}{"id": vpcID},
+		"zone":                      map[string]interface{}{"name": "us-south-1"},
+		"profile":                   map[string]interface{}{"name": "bx2-2x8"},
+		"image":                     map[string]interface{}{"id": "r006-image"},
+		"keys":                      []interface{}{map[string]interface{}{"id": "r006-key"}},
+		"primary_network_interface": map[string]interface{}{"subnet": map[string]interface{}{"id": subnetID}},
+	})
+	instanceID := instance["id"].(string)
+	if _, v := call(t, s, "GET", "/instances/"+instanceID, nil); v["status"] != "pending" {
+		t.Errorf("Expected the instance to be pending, got %v", v["status"])
+	}
+	if _, v := call(t, s, "GET", "/instances/"+instanceID, nil); v["status"] != "running" {
+		t.Errorf("Expected the instance to be running, got %v", v["status"])
+	}
+	if status, _ := call(t, s, "POST", "/instances/"+instanceID+"/actions", map[string]interface{}{"type": "stop"}); status != http.StatusCreated {
+		t.Errorf("Unexpected action status %d", status)
+	}
+	for _, expected := range []string{"stopping", "stopped"} {
+		if _, v := call(t, s, "GET", "/instances/"+instanceID, nil); v["status"] != expected {
+			t.Errorf("Expected the instance to be %s, got %v", expected, v["status"])
+		}
+	}
+	if _, init := call(t, s, "GET", "/instances/"+instanceID+"/initialization", nil); len(init["keys"].([]interface{})) != 1 {
+		t.Errorf("Expected the key in the initialization, got %v", init)
+	}
+	nicID := refID(instance["primary_network_interface"])
+	if status, _ := call(t, s, "GET", "/instances/"+instanceID+"/network_interfaces/"+nicID, nil); status != http.StatusOK {
+		t.Errorf("Expected the network interface, got %d", status)
+	}
+
+	_, fip := call(t, s, "POST", "/floating_ips", map[string]interface{}{"name": "fip1", "target": map[string]interface{}{"id": nicID}})
+	if refID(fip["target"]) != nicID || fip["zone"].(map[string]interface{})["name"] != "us-south-1" {
+		t.Errorf("Unexpected floating IP %v", fip)
+	}
+
+	if _, list := call(t, s, "GET", "/security_groups?vpc.id="+vpcID, nil); list["total_count"] != float64(1) {
+		t.Errorf("Expected the security groups of the VPC, got %v", list)
+	}
+
+	if status, _ := call(t, s, "DELETE", "/instances/"+instanceID, nil); status != http.StatusNoContent {
+		t.Errorf("Unexpected delete status %d", status)
+	}
+	if _, v := call(t, s, "GET", "/instances/"+instanceID, nil); v["status"] != "deleting" {
+		t.Errorf("Expected the instance to be deleting, got %v", v)
+	}
+	if status, _ := call(t, s, "GET", "/instances/"+instanceID, nil); status != http.StatusNotFound {
+		t.Errorf("Expected the instance to be gone, got %d", status)
+	}
+	if len(s.IDs(Volumes)) != 0 {
+		t.Errorf("Expected the boot volume to be deleted, got %v", s.IDs(Volumes))
+	}
+}
+
+func TestServerFailures(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.Fail("POST", "/vpcs", http.StatusServiceUnavailable, 2)
+
+	for i := 0; i < 2; i++ {
+		status, body := call(t, s, "POST", "/vpcs", map[string]interface{}{"name": "vpc1"})
+		if status != http.StatusServiceUnavailable || body["errors"] == nil {
+			t.Errorf("Expected an injected failure, got %d %v", status, body)
+		}
+	}
+	if status, _ := call(t, s, "POST", "/vpcs", map[string]interface{}{"name": "vpc1"}); status != http.StatusCreated {
+		t.Errorf("Expected the failures to be over, got %d", status)
+	}
+	if status, body := call(t, s, "GET", "/vpcs/unknown", nil); status != http.StatusNotFound || body["trace"] == nil {
+		t.Errorf("Expected a not found error, got %d %v", status, body)
+	}
+	if len(s.Requests()) != 4 {
+		t.Errorf("Expected 4 requests, got %v", s.Requests())
+	}
+}
+
+func TestServerLoadBalancerPoolMembers(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	_, vpc := call(t, s, "POST", "/vpcs", map[string]interface{}{"name": "vpc1"})
+	_, subnet := call(t, s, "POST", "/subnets", map[string]interface{}{"name": "subnet1", "vpc": map[string]interface{}{"id": vpc["id"]}})
+	status, lb := call(t, s, "POST", "/load_balancers", map[string]interface{}{
+		"name":    "lb1",
+		"subnets": []interface{}{map[string]interface{}{"id": subnet["id"]}},
+		"pools":   []interface{}{map[string]interface{}{"name": "pool1"}},
+	})
+	if status != http.StatusCreated || lb["provisioning_status"] != "active" {
+		t.Fatalf("Unexpected create response %d %v", status, lb)
+	}
+	poolPath := "/load_balancers/" + lb["id"].(string) + "/pools/" + refID(lb["pools"].([]interface{})[0])
+
+	status, _ = call(t, s, "PUT", poolPath+"/members", map[string]interface{}{
+		"members": []interface{}{
+			map[string]interface{}{"port": 80, "target": map[string]interface{}{"address": "10.0.0.1"}},
+			map[string]interface{}{"port": 80, "target": map[string]interface{}{"address": "10.0.0.2"}, "weight": 20},
+		},
+	})
+	if status != http.StatusAccepted {
+		t.Errorf("Unexpected replace status %d", status)
+	}
+	if _, members := call(t, s, "GET", poolPath+"/members", nil); len(members["members"].([]interface{})) != 2 {
+		t.Errorf("Expected 2 members, got %v", members)
+	}
+	if _, pool := call(t, s, "GET", poolPath, nil); pool["provisioning_status"] != "active" || len(pool["members"].([]interface{})) != 2 {
+		t.Errorf("Expected the pool to reference its members, got %v", pool)
+	}
+	if status, _ := call(t, s, "GET", "/load_balancers/"+lb["id"].(string)+"/pools/unknown", nil); status != http.StatusNotFound {
+		t.Errorf("Expected an unknown pool to be not found, got %d", status)
+	}
+}
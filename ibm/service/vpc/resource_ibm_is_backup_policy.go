@@ -0,0 +1,354 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isBackupPolicyDeleting  = "deleting"
+	isBackupPolicyDeleted   = "done"
+	isBackupPolicyFailed    = "failed"
+	isBackupPolicyPending   = "pending"
+	isBackupPolicyStable    = "stable"
+	isBackupPolicySuspended = "suspended"
+	isBackupPolicyUpdating  = "updating"
+	isBackupPolicyWaiting   = "waiting"
+)
+
+func ResourceIBMIsBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBackupPolicyCreate,
+		ReadContext:   resourceIBMIsBackupPolicyRead,
+		UpdateContext: resourceIBMIsBackupPolicyUpdate,
+		DeleteContext: resourceIBMIsBackupPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"match_resource_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy", "match_resource_types")},
+				Description: "The resource types this backup policy applies to, volume is the only supported value.",
+			},
+			"match_user_tags": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy", "match_user_tags")},
+				Description: "The user tags this backup policy applies to. Resources that have both a matching user tag and a matching type are backed up by this backup policy.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy", "name"),
+				Description:  "The user-defined name for this backup policy. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the backup policy was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this backup policy.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this backup policy.",
+			},
+			"last_job_completed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the most recent job for this backup policy completed.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the backup policy.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBackupPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "match_resource_types",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "volume",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "match_user_tags",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_backup_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createBackupPolicyOptions := &vpcv1.CreateBackupPolicyOptions{}
+	createBackupPolicyOptions.SetMatchUserTags(flex.ExpandStringList(d.Get("match_user_tags").(*schema.Set).List()))
+	if v, ok := d.GetOk("match_resource_types"); ok && v.(*schema.Set).Len() > 0 {
+		createBackupPolicyOptions.SetMatchResourceTypes(flex.ExpandStringList(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("name"); ok {
+		createBackupPolicyOptions.SetName(v.(string))
+	}
+	if v, ok := d.GetOk("resource_group"); ok {
+		resourceGroup := v.(string)
+		createBackupPolicyOptions.SetResourceGroup(&vpcv1.ResourceGroupIdentity{
+			ID: &resourceGroup,
+		})
+	}
+
+	backupPolicy, response, err := vpcClient.CreateBackupPolicyWithContext(context, createBackupPolicyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBackupPolicyWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "CreateBackupPolicy", err, response)
+	}
+
+	d.SetId(*backupPolicy.ID)
+
+	_, err = isWaitForBackupPolicyStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for backup policy (%s) to be available: %s", d.Id(), err))
+	}
+
+	return resourceIBMIsBackupPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsBackupPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{}
+	getBackupPolicyOptions.SetID(d.Id())
+
+	backupPolicy, response, err := vpcClient.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "GetBackupPolicy", err, response)
+	}
+
+	if err = d.Set("match_resource_types", backupPolicy.MatchResourceTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting match_resource_types: %s", err))
+	}
+	if err = d.Set("match_user_tags", backupPolicy.MatchUserTags); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting match_user_tags: %s", err))
+	}
+	if err = d.Set("name", backupPolicy.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if backupPolicy.ResourceGroup != nil {
+		if err = d.Set("resource_group", *backupPolicy.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+		}
+	}
+	if err = d.Set("created_at", backupPolicy.CreatedAt.String()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", backupPolicy.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("href", backupPolicy.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if backupPolicy.LastJobCompletedAt != nil {
+		if err = d.Set("last_job_completed_at", backupPolicy.LastJobCompletedAt.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting last_job_completed_at: %s", err))
+		}
+	}
+	if err = d.Set("lifecycle_state", backupPolicy.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", backupPolicy.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsBackupPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hasChange := false
+	backupPolicyPatchModel := &vpcv1.BackupPolicyPatch{}
+	if d.HasChange("match_user_tags") {
+		backupPolicyPatchModel.MatchUserTags = flex.ExpandStringList(d.Get("match_user_tags").(*schema.Set).List())
+		hasChange = true
+	}
+	if d.HasChange("name") {
+		backupPolicyPatchModel.Name = flex.PtrToString(d.Get("name").(string))
+		hasChange = true
+	}
+
+	if hasChange {
+		backupPolicyPatch, err := backupPolicyPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for BackupPolicyPatch: %s", err))
+		}
+		updateBackupPolicyOptions := &vpcv1.UpdateBackupPolicyOptions{}
+		updateBackupPolicyOptions.SetID(d.Id())
+		updateBackupPolicyOptions.SetBackupPolicyPatch(backupPolicyPatch)
+		_, response, err := vpcClient.UpdateBackupPolicyWithContext(context, updateBackupPolicyOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBackupPolicyWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "UpdateBackupPolicy", err, response)
+		}
+	}
+
+	return resourceIBMIsBackupPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsBackupPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteBackupPolicyOptions := &vpcv1.DeleteBackupPolicyOptions{}
+	deleteBackupPolicyOptions.SetID(d.Id())
+
+	_, response, err := vpcClient.DeleteBackupPolicyWithContext(context, deleteBackupPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBackupPolicyWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "DeleteBackupPolicy", err, response)
+	}
+
+	_, err = isWaitForBackupPolicyDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for backup policy (%s) to be deleted: %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForBackupPolicyStable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyPending, isBackupPolicyUpdating, isBackupPolicyWaiting},
+		Target:  []string{isBackupPolicyStable},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{}
+			getBackupPolicyOptions.SetID(id)
+			backupPolicy, response, err := vpcClient.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy: %s\n%s", err, response)
+			}
+			if *backupPolicy.LifecycleState == isBackupPolicyFailed || *backupPolicy.LifecycleState == isBackupPolicySuspended {
+				return backupPolicy, *backupPolicy.LifecycleState, fmt.Errorf("[ERROR] The backup policy %s is %s", id, *backupPolicy.LifecycleState)
+			}
+			return backupPolicy, *backupPolicy.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForBackupPolicyDeleted(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyDeleting, isBackupPolicyStable, isBackupPolicyUpdating},
+		Target:  []string{isBackupPolicyDeleted},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{}
+			getBackupPolicyOptions.SetID(id)
+			backupPolicy, response, err := vpcClient.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return backupPolicy, isBackupPolicyDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy: %s\n%s", err, response)
+			}
+			if *backupPolicy.LifecycleState == isBackupPolicyFailed {
+				return backupPolicy, *backupPolicy.LifecycleState, fmt.Errorf("[ERROR] The backup policy %s failed to delete", id)
+			}
+			return backupPolicy, *backupPolicy.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,472 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func ResourceIBMIsBackupPolicyPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBackupPolicyPlanCreate,
+		ReadContext:   resourceIBMIsBackupPolicyPlanRead,
+		UpdateContext: resourceIBMIsBackupPolicyPlanUpdate,
+		DeleteContext: resourceIBMIsBackupPolicyPlanDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the backup policy.",
+			},
+			"cron_spec": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", "cron_spec"),
+				Description:  "The cron specification for the backup schedule, in UTC. The backups can't be taken more often than once a day.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the plan is active.",
+			},
+			"attach_user_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The user tags to attach to each backup (snapshot) created by this plan.",
+			},
+			"copy_user_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether to copy the source's user tags to the created backups (snapshots).",
+			},
+			"deletion_trigger": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The retention of the backups (snapshots) created by this plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_after": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", "delete_after"),
+							Description:  "The maximum number of days to keep each backup after creation.",
+						},
+						"delete_over_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", "delete_over_count"),
+							Description:  "The maximum number of recent backups to keep. If unspecified, there is no maximum.",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_backup_policy_plan", "name"),
+				Description:  "The user-defined name for this backup policy plan. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			"backup_policy_plan_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this backup policy plan.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the backup policy plan was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this backup policy plan.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of this backup policy plan.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBackupPolicyPlanValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cron_spec",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^((((\d+,)+\d+|([\d\*]+(\/|-)\d+)|\d+|\*) ?){5,7})$`,
+			MinValueLength:             9,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "delete_after",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "1000",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "delete_over_count",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "750",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_backup_policy_plan", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsBackupPolicyPlanCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicyID := d.Get("backup_policy_id").(string)
+	createBackupPolicyPlanOptions := &vpcv1.CreateBackupPolicyPlanOptions{}
+	createBackupPolicyPlanOptions.SetBackupPolicyID(backupPolicyID)
+	createBackupPolicyPlanOptions.SetCronSpec(d.Get("cron_spec").(string))
+	if v, ok := d.GetOkExists("active"); ok {
+		createBackupPolicyPlanOptions.SetActive(v.(bool))
+	}
+	if v, ok := d.GetOk("attach_user_tags"); ok {
+		createBackupPolicyPlanOptions.SetAttachUserTags(flex.ExpandStringList(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOkExists("copy_user_tags"); ok {
+		createBackupPolicyPlanOptions.SetCopyUserTags(v.(bool))
+	}
+	if _, ok := d.GetOk("deletion_trigger"); ok {
+		deletionTrigger := &vpcv1.BackupPolicyPlanDeletionTriggerPrototype{}
+		if v, ok := d.GetOk("deletion_trigger.0.delete_after"); ok {
+			deletionTrigger.DeleteAfter = core.Int64Ptr(int64(v.(int)))
+		}
+		if v, ok := d.GetOk("deletion_trigger.0.delete_over_count"); ok {
+			deletionTrigger.DeleteOverCount = core.Int64Ptr(int64(v.(int)))
+		}
+		createBackupPolicyPlanOptions.SetDeletionTrigger(deletionTrigger)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		createBackupPolicyPlanOptions.SetName(v.(string))
+	}
+
+	backupPolicyPlan, response, err := vpcClient.CreateBackupPolicyPlanWithContext(context, createBackupPolicyPlanOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBackupPolicyPlanWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "CreateBackupPolicyPlan", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", backupPolicyID, *backupPolicyPlan.ID))
+
+	_, err = isWaitForBackupPolicyPlanStable(context, vpcClient, backupPolicyID, *backupPolicyPlan.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for backup policy plan (%s) to be available: %s", d.Id(), err))
+	}
+
+	return resourceIBMIsBackupPolicyPlanRead(context, d, meta)
+}
+
+func resourceIBMIsBackupPolicyPlanRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid backup policy plan ID %s, expected backup_policy_id/backup_policy_plan_id", d.Id()))
+	}
+
+	getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{}
+	getBackupPolicyPlanOptions.SetBackupPolicyID(parts[0])
+	getBackupPolicyPlanOptions.SetID(parts[1])
+
+	backupPolicyPlan, response, err := vpcClient.GetBackupPolicyPlanWithContext(context, getBackupPolicyPlanOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBackupPolicyPlanWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "GetBackupPolicyPlan", err, response)
+	}
+
+	if err = d.Set("backup_policy_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting backup_policy_id: %s", err))
+	}
+	if err = d.Set("backup_policy_plan_id", backupPolicyPlan.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting backup_policy_plan_id: %s", err))
+	}
+	if err = d.Set("cron_spec", backupPolicyPlan.CronSpec); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cron_spec: %s", err))
+	}
+	if err = d.Set("active", backupPolicyPlan.Active); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting active: %s", err))
+	}
+	if err = d.Set("attach_user_tags", backupPolicyPlan.AttachUserTags); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting attach_user_tags: %s", err))
+	}
+	if err = d.Set("copy_user_tags", backupPolicyPlan.CopyUserTags); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting copy_user_tags: %s", err))
+	}
+	if err = d.Set("deletion_trigger", resourceIBMIsBackupPolicyPlanFlattenDeletionTrigger(backupPolicyPlan.DeletionTrigger)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting deletion_trigger: %s", err))
+	}
+	if err = d.Set("name", backupPolicyPlan.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("created_at", backupPolicyPlan.CreatedAt.String()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("href", backupPolicyPlan.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", backupPolicyPlan.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", backupPolicyPlan.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsBackupPolicyPlanFlattenDeletionTrigger(deletionTrigger *vpcv1.BackupPolicyPlanDeletionTrigger) []map[string]interface{} {
+	if deletionTrigger == nil {
+		return []map[string]interface{}{}
+	}
+	deletionTriggerMap := map[string]interface{}{}
+	if deletionTrigger.DeleteAfter != nil {
+		deletionTriggerMap["delete_after"] = flex.IntValue(deletionTrigger.DeleteAfter)
+	}
+	if deletionTrigger.DeleteOverCount != nil {
+		deletionTriggerMap["delete_over_count"] = flex.IntValue(deletionTrigger.DeleteOverCount)
+	}
+	return []map[string]interface{}{deletionTriggerMap}
+}
+
+func resourceIBMIsBackupPolicyPlanUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid backup policy plan ID %s, expected backup_policy_id/backup_policy_plan_id", d.Id()))
+	}
+
+	hasChange := false
+	removeDeleteOverCount := false
+	removeAttachUserTags := false
+	backupPolicyPlanPatchModel := &vpcv1.BackupPolicyPlanPatch{}
+	if d.HasChange("cron_spec") {
+		backupPolicyPlanPatchModel.CronSpec = flex.PtrToString(d.Get("cron_spec").(string))
+		hasChange = true
+	}
+	if d.HasChange("active") {
+		backupPolicyPlanPatchModel.Active = core.BoolPtr(d.Get("active").(bool))
+		hasChange = true
+	}
+	if d.HasChange("attach_user_tags") {
+		backupPolicyPlanPatchModel.AttachUserTags = flex.ExpandStringList(d.Get("attach_user_tags").(*schema.Set).List())
+		removeAttachUserTags = len(backupPolicyPlanPatchModel.AttachUserTags) == 0
+		hasChange = true
+	}
+	if d.HasChange("copy_user_tags") {
+		backupPolicyPlanPatchModel.CopyUserTags = core.BoolPtr(d.Get("copy_user_tags").(bool))
+		hasChange = true
+	}
+	if d.HasChange("deletion_trigger") {
+		deletionTrigger := &vpcv1.BackupPolicyPlanDeletionTriggerPatch{}
+		if v, ok := d.GetOk("deletion_trigger.0.delete_after"); ok {
+			deletionTrigger.DeleteAfter = core.Int64Ptr(int64(v.(int)))
+		}
+		if v, ok := d.GetOk("deletion_trigger.0.delete_over_count"); ok {
+			deletionTrigger.DeleteOverCount = core.Int64Ptr(int64(v.(int)))
+		} else {
+			removeDeleteOverCount = true
+		}
+		backupPolicyPlanPatchModel.DeletionTrigger = deletionTrigger
+		hasChange = true
+	}
+	if d.HasChange("name") {
+		backupPolicyPlanPatchModel.Name = flex.PtrToString(d.Get("name").(string))
+		hasChange = true
+	}
+
+	if hasChange {
+		backupPolicyPlanPatch, err := backupPolicyPlanPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for BackupPolicyPlanPatch: %s", err))
+		}
+		// The maximum number of backups is removed by patching it to null, which
+		// AsPatch omits.
+		if removeDeleteOverCount {
+			deletionTriggerPatch, _ := backupPolicyPlanPatch["deletion_trigger"].(map[string]interface{})
+			if deletionTriggerPatch == nil {
+				deletionTriggerPatch = map[string]interface{}{}
+			}
+			deletionTriggerPatch["delete_over_count"] = nil
+			backupPolicyPlanPatch["deletion_trigger"] = deletionTriggerPatch
+		}
+		// An empty list is dropped from the patch, it is the way to remove
+		// all the tags
+		if removeAttachUserTags {
+			backupPolicyPlanPatch["attach_user_tags"] = []interface{}{}
+		}
+		updateBackupPolicyPlanOptions := &vpcv1.UpdateBackupPolicyPlanOptions{}
+		updateBackupPolicyPlanOptions.SetBackupPolicyID(parts[0])
+		updateBackupPolicyPlanOptions.SetID(parts[1])
+		updateBackupPolicyPlanOptions.SetBackupPolicyPlanPatch(backupPolicyPlanPatch)
+		_, response, err := vpcClient.UpdateBackupPolicyPlanWithContext(context, updateBackupPolicyPlanOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBackupPolicyPlanWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "UpdateBackupPolicyPlan", err, response)
+		}
+	}
+
+	return resourceIBMIsBackupPolicyPlanRead(context, d, meta)
+}
+
+func resourceIBMIsBackupPolicyPlanDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid backup policy plan ID %s, expected backup_policy_id/backup_policy_plan_id", d.Id()))
+	}
+
+	deleteBackupPolicyPlanOptions := &vpcv1.DeleteBackupPolicyPlanOptions{}
+	deleteBackupPolicyPlanOptions.SetBackupPolicyID(parts[0])
+	deleteBackupPolicyPlanOptions.SetID(parts[1])
+
+	_, response, err := vpcClient.DeleteBackupPolicyPlanWithContext(context, deleteBackupPolicyPlanOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBackupPolicyPlanWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "DeleteBackupPolicyPlan", err, response)
+	}
+
+	_, err = isWaitForBackupPolicyPlanDeleted(context, vpcClient, parts[0], parts[1], d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for backup policy plan (%s) to be deleted: %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForBackupPolicyPlanStable(context context.Context, vpcClient *vpcv1.VpcV1, backupPolicyID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy plan (%s/%s) to be stable.", backupPolicyID, id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyPending, isBackupPolicyUpdating, isBackupPolicyWaiting},
+		Target:  []string{isBackupPolicyStable},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{}
+			getBackupPolicyPlanOptions.SetBackupPolicyID(backupPolicyID)
+			getBackupPolicyPlanOptions.SetID(id)
+			backupPolicyPlan, response, err := vpcClient.GetBackupPolicyPlanWithContext(context, getBackupPolicyPlanOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy plan: %s\n%s", err, response)
+			}
+			if *backupPolicyPlan.LifecycleState == isBackupPolicyFailed || *backupPolicyPlan.LifecycleState == isBackupPolicySuspended {
+				return backupPolicyPlan, *backupPolicyPlan.LifecycleState, fmt.Errorf("[ERROR] The backup policy plan %s is %s", id, *backupPolicyPlan.LifecycleState)
+			}
+			return backupPolicyPlan, *backupPolicyPlan.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForBackupPolicyPlanDeleted(context context.Context, vpcClient *vpcv1.VpcV1, backupPolicyID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy plan (%s/%s) to be deleted.", backupPolicyID, id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyDeleting, isBackupPolicyStable, isBackupPolicyUpdating},
+		Target:  []string{isBackupPolicyDeleted},
+		Refresh: func() (interface{}, string, error) {
+			getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{}
+			getBackupPolicyPlanOptions.SetBackupPolicyID(backupPolicyID)
+			getBackupPolicyPlanOptions.SetID(id)
+			backupPolicyPlan, response, err := vpcClient.GetBackupPolicyPlanWithContext(context, getBackupPolicyPlanOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return backupPolicyPlan, isBackupPolicyDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting backup policy plan: %s\n%s", err, response)
+			}
+			if *backupPolicyPlan.LifecycleState == isBackupPolicyFailed {
+				return backupPolicyPlan, *backupPolicyPlan.LifecycleState, fmt.Errorf("[ERROR] The backup policy plan %s failed to delete", id)
+			}
+			return backupPolicyPlan, *backupPolicyPlan.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsBackupPolicyPlanBasic(t *testing.T) {
+	backupPolicyName := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsBackupPolicyPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsBackupPolicyPlanConfigBasic(backupPolicyName, name, "30 09 * * *", 20, "delete_over_count = 5", `["tf-backup-plan"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "cron_spec", "30 09 * * *"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "copy_user_tags", "true"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "attach_user_tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "deletion_trigger.0.delete_after", "20"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "deletion_trigger.0.delete_over_count", "5"),
+					resource.TestCheckResourceAttrSet("ibm_is_backup_policy_plan.is_backup_policy_plan", "backup_policy_plan_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsBackupPolicyPlanConfigBasic(backupPolicyName, name, "30 10 * * *", 30, "", "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "cron_spec", "30 10 * * *"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "deletion_trigger.0.delete_after", "30"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "deletion_trigger.0.delete_over_count", "0"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan", "attach_user_tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_backup_policy_plan.is_backup_policy_plan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsBackupPolicyPlanConfigBasic(backupPolicyName, name, cronSpec string, deleteAfter int, deleteOverCount, attachUserTags string) string {
+	return testAccCheckIBMIsBackupPolicyConfigBasic(backupPolicyName, "tf-backup") + fmt.Sprintf(`
+	resource "ibm_is_backup_policy_plan" "is_backup_policy_plan" {
+		backup_policy_id = ibm_is_backup_policy.is_backup_policy.id
+		cron_spec        = "%s"
+		active           = true
+		attach_user_tags = %s
+		copy_user_tags   = true
+		deletion_trigger {
+			delete_after = %d
+			%s
+		}
+		name = "%s"
+	}
+	`, cronSpec, attachUserTags, deleteAfter, deleteOverCount, name)
+}
+
+func testAccCheckIBMIsBackupPolicyPlanDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_backup_policy_plan" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		getBackupPolicyPlanOptions := &vpcv1.GetBackupPolicyPlanOptions{}
+		getBackupPolicyPlanOptions.SetBackupPolicyID(parts[0])
+		getBackupPolicyPlanOptions.SetID(parts[1])
+
+		_, response, err := vpcClient.GetBackupPolicyPlan(getBackupPolicyPlanOptions)
+		if err == nil {
+			return fmt.Errorf("BackupPolicyPlan still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for BackupPolicyPlan (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsBackupPolicyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-backup-policy-update-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsBackupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsBackupPolicyConfigBasic(name, "tf-backup"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "match_user_tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "match_resource_types.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_backup_policy.is_backup_policy", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsBackupPolicyConfigBasic(nameUpdate, "tf-backup-update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.is_backup_policy", "match_user_tags.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_backup_policy.is_backup_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsBackupPolicyConfigBasic(name, tag string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_backup_policy" "is_backup_policy" {
+		match_user_tags = ["%s"]
+		name            = "%s"
+	}
+	`, tag, name)
+}
+
+func testAccCheckIBMIsBackupPolicyDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_backup_policy" {
+			continue
+		}
+
+		getBackupPolicyOptions := &vpcv1.GetBackupPolicyOptions{}
+		getBackupPolicyOptions.SetID(rs.Primary.ID)
+
+		_, response, err := vpcClient.GetBackupPolicy(getBackupPolicyOptions)
+		if err == nil {
+			return fmt.Errorf("BackupPolicy still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for BackupPolicy (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
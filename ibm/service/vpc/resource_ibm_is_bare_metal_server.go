@@ -91,7 +91,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 		),
@@ -549,6 +549,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the Bare metal server",
 			},
+			"tags_all": flex.TagsAllSchema(),
 		},
 	}
 }
@@ -930,7 +931,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
+	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *bms.CRN)
 		if err != nil {
@@ -1215,6 +1216,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			"[ERROR] Error on get of resource bare metal server (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isBareMetalServerTags, tags)
+	d.Set("tags_all", tags)
 
 	return nil
 }
@@ -1237,7 +1239,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		return err
 	}
 
-	if d.HasChange(isBareMetalServerTags) {
+	if d.HasChange(isBareMetalServerTags) || d.HasChange("tags_all") {
 		bmscrn := d.Get(isBareMetalServerCRN).(string)
 		if bmscrn == "" {
 			options := &vpcv1.GetBareMetalServerOptions{
@@ -53,7 +53,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -216,6 +216,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Floating IP tags",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -339,7 +340,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "CreateFloatingIP", err, response)
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
@@ -348,7 +349,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
+	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *floatingip.CRN)
 		if err != nil {
@@ -383,7 +384,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetFloatingIP", err, response)
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -405,6 +406,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of vpc Floating IP (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isFloatingIPTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -435,13 +437,13 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isFloatingIPTags) {
+	if d.HasChange(isFloatingIPTags) || d.HasChange("tags_all") {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "GetFloatingIP", err, response)
 		}
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
@@ -481,7 +483,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "UpdateFloatingIP", err, response)
 		}
 	}
 	return nil
@@ -510,7 +512,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetFloatingIP", err, response)
 	}
 
 	options := &vpcv1.DeleteFloatingIPOptions{
@@ -518,7 +520,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Floating IP : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeleteFloatingIP", err, response)
 	}
 	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -51,7 +51,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -137,6 +137,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the VPC Flow logs",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -238,7 +239,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
@@ -312,6 +313,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 			"Error on get of resource vpc flow log (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isFlowLogTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -347,7 +349,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
 	}
 
-	if d.HasChange(isFlowLogTags) {
+	if d.HasChange(isFlowLogTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
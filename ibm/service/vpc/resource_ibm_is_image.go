@@ -59,7 +59,7 @@ func ResourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -102,6 +102,7 @@ func ResourceIBMISImage() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the image",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			isImageOperatingSystem: {
 				Type:         schema.TypeString,
@@ -295,7 +296,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -384,7 +385,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
@@ -450,7 +451,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isImageTags) {
+	if d.HasChange(isImageTags) || d.HasChange("tags_all") {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
@@ -549,6 +550,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc Image (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isImageTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -106,6 +106,12 @@ const (
 	isInstanceDefaultTrustedProfileAutoLink = "default_trusted_profile_auto_link"
 	isInstanceDefaultTrustedProfileTarget   = "default_trusted_profile_target"
 	isInstanceMetadataServiceEnabled        = "metadata_service_enabled"
+
+	isInstanceUpdatePolicy             = "update_policy"
+	isInstanceUpdatePolicyAllowStop    = "allow_stop"
+	isInstanceUpdatePolicyStopType     = "stop_type"
+	isInstanceUpdatePolicyRestartAfter = "restart_after"
+	isInstanceUpdatePolicyTimeout      = "timeout"
 )
 
 func ResourceIBMISInstance() *schema.Resource {
@@ -156,7 +162,7 @@ func ResourceIBMISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -286,6 +292,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "list of tags for the instance",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			isEnableCleanDelete: {
 				Type:             schema.TypeBool,
@@ -310,6 +317,41 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description:  "If set to true, the action will be forced immediately, and all queued actions deleted. Ignored for the start action.",
 			},
 
+			isInstanceUpdatePolicy: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "How a profile change, which needs the instance to be stopped, is carried out. The other updates are applied to the running instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceUpdatePolicyAllowStop: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether a running instance can be stopped for the update. If false, the update fails while the instance is running",
+						},
+						isInstanceUpdatePolicyStopType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "soft",
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceUpdatePolicyStopType),
+							Description:  "How the instance is stopped: soft waits for the queued actions, hard forces the stop immediately",
+						},
+						isInstanceUpdatePolicyRestartAfter: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the instance is started after the update",
+						},
+						isInstanceUpdatePolicyTimeout: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The timeout in minutes of each stop and start of the instance. Defaults to the update timeout",
+						},
+					},
+				},
+			},
+
 			isInstanceVolumeAttachments: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -892,6 +934,13 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Optional:                   true,
 			AllowedValues:              actions})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceUpdatePolicyStopType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "soft, hard"})
 
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -1250,7 +1299,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1607,7 +1656,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1956,7 +2005,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2399,6 +2448,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource Instance (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isInstanceTags, tags)
+	d.Set("tags_all", tags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -2885,64 +2935,73 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange(isInstanceProfile) && !d.IsNewResource() {
-
-		getinsOptions := &vpcv1.GetInstanceOptions{
-			ID: &id,
-		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		err = instanceUpdateProfile(instanceC, d, id)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				d.SetId("")
-				return nil
-			}
-			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+			return err
 		}
-
-		if instance != nil && *instance.Status == "running" {
-			actiontype := "stop"
-			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-				InstanceID: &id,
-				Type:       &actiontype,
-			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-			if err != nil {
-				if response != nil && response.StatusCode == 404 {
-					return nil
-				}
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
-			if err != nil {
-				return err
-			}
+		// The instance is gone
+		if d.Id() == "" {
+			return nil
 		}
+	}
 
-		updnetoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := instanceC.GetInstance(getinsOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+	}
+	if d.HasChange(isInstanceTags) || d.HasChange("tags_all") {
+		oldList, newList := d.GetChange(isInstanceTags)
+		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
+		if err != nil {
+			log.Printf(
+				"Error on update of resource Instance (%s) tags: %s", d.Id(), err)
 		}
+	}
+	return nil
+}
 
-		instanceProfile := d.Get(isInstanceProfile).(string)
-		profile := &vpcv1.InstancePatchProfile{
-			Name: &instanceProfile,
-		}
-		instancePatchModel := &vpcv1.InstancePatch{
-			Profile: profile,
-		}
-		instancePatch, err := instancePatchModel.AsPatch()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
+// instanceUpdateProfile resizes the instance, which has to be stopped for it.
+// The update_policy decides whether and how a running instance is stopped, and
+// whether it is started again. When the instance fails to start with the new
+// profile, the old profile is restored and the instance started with it.
+func instanceUpdateProfile(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) error {
+	allowStop, stopType, restartAfter := true, "soft", true
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if policyList, ok := d.GetOk(isInstanceUpdatePolicy); ok && len(policyList.([]interface{})) > 0 && policyList.([]interface{})[0] != nil {
+		policy := policyList.([]interface{})[0].(map[string]interface{})
+		allowStop = policy[isInstanceUpdatePolicyAllowStop].(bool)
+		stopType = policy[isInstanceUpdatePolicyStopType].(string)
+		restartAfter = policy[isInstanceUpdatePolicyRestartAfter].(bool)
+		if minutes := policy[isInstanceUpdatePolicyTimeout].(int); minutes > 0 {
+			timeout = time.Duration(minutes) * time.Minute
 		}
-		updnetoptions.InstancePatch = instancePatch
+	}
 
-		_, response, err = instanceC.UpdateInstance(updnetoptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := instanceC.GetInstance(getinsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
 		}
+		return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+	}
 
-		actiontype := "start"
+	if instance != nil && *instance.Status == "running" {
+		if !allowStop {
+			return fmt.Errorf("[ERROR] Error changing the profile of instance (%s): the instance is running and update_policy.allow_stop is false", id)
+		}
+		actiontype := "stop"
+		force := stopType == "hard"
 		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 			InstanceID: &id,
 			Type:       &actiontype,
+			Force:      &force,
 		}
 		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 		if err != nil {
@@ -2951,31 +3010,83 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceActionStop(instanceC, timeout, id, d)
 		if err != nil {
 			return err
 		}
+	}
 
+	oldProfile, newProfile := d.GetChange(isInstanceProfile)
+	err = instanceUpdateProfileName(instanceC, id, newProfile.(string))
+	if err != nil {
+		// The instance is started again with its old profile
+		if restartAfter {
+			if startErr := instanceStart(instanceC, d, id, timeout); startErr != nil {
+				log.Printf("[WARN] Error starting instance (%s) after its profile failed to change: %s", id, startErr)
+			}
+		}
+		return err
+	}
+	if !restartAfter {
+		return nil
 	}
 
-	getinsOptions := &vpcv1.GetInstanceOptions{
+	err = instanceStart(instanceC, d, id, timeout)
+	if err != nil {
+		log.Printf("[WARN] Instance (%s) failed to start with profile %s, rolling back to profile %s: %s", id, newProfile, oldProfile, err)
+		rollbackErr := instanceUpdateProfileName(instanceC, id, oldProfile.(string))
+		if rollbackErr == nil {
+			rollbackErr = instanceStart(instanceC, d, id, timeout)
+		}
+		if rollbackErr != nil {
+			return fmt.Errorf("[ERROR] Error starting instance (%s) with profile %s: %s\n[ERROR] Error rolling back to profile %s: %s", id, newProfile, err, oldProfile, rollbackErr)
+		}
+		d.Set(isInstanceProfile, oldProfile)
+		return fmt.Errorf("[ERROR] Error starting instance (%s) with profile %s, the profile was rolled back to %s: %s", id, newProfile, oldProfile, err)
+	}
+	return nil
+}
+
+func instanceUpdateProfileName(instanceC *vpcv1.VpcV1, id, instanceProfile string) error {
+	updnetoptions := &vpcv1.UpdateInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	profile := &vpcv1.InstancePatchProfile{
+		Name: &instanceProfile,
+	}
+	instancePatchModel := &vpcv1.InstancePatch{
+		Profile: profile,
+	}
+	instancePatch, err := instancePatchModel.AsPatch()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
 	}
-	if d.HasChange(isInstanceTags) {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
-		if err != nil {
-			log.Printf(
-				"Error on update of resource Instance (%s) tags: %s", d.Id(), err)
-		}
+	updnetoptions.InstancePatch = instancePatch
+
+	_, response, err := instanceC.UpdateInstance(updnetoptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
 	}
 	return nil
 }
 
+func instanceStart(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string, timeout time.Duration) error {
+	actiontype := "start"
+	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &id,
+		Type:       &actiontype,
+	}
+	_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+	}
+	_, err = isWaitForInstanceActionStart(instanceC, timeout, id, d)
+	return err
+}
+
 func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err := instanceUpdate(d, meta)
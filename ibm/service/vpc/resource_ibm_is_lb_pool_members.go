@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBPoolMembers = "members"
+)
+
+func ResourceIBMISLBPoolMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISLBPoolMembersCreate,
+		ReadContext:   resourceIBMISLBPoolMembersRead,
+		UpdateContext: resourceIBMISLBPoolMembersUpdate,
+		DeleteContext: resourceIBMISLBPoolMembersDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isLBID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Load balancer ID",
+			},
+
+			isLBPoolID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// The pool can be given as the ID of an ibm_is_lb_pool
+					poolID, err := getPoolId(n)
+					return err == nil && o == poolID
+				},
+				Description: "Load balancer pool ID",
+			},
+
+			isLBPoolMembers: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The members of the load balancer pool. Members which are not listed are removed from the pool.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBPoolMemberPort: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The port the member receives load balancer traffic on.",
+						},
+						isLBPoolMemberTargetAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IP address of the member, for application load balancers. Conflicts with target_id.",
+						},
+						isLBPoolMemberTargetID: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the virtual server instance of the member, for network load balancers. Conflicts with target_address.",
+						},
+						isLBPoolMemberWeight: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool_members", isLBPoolMemberWeight),
+							Description:  "The weight of the member, for pools with the weighted_round_robin algorithm.",
+						},
+					},
+				},
+			},
+
+			flex.RelatedCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the LB resource",
+			},
+		},
+	}
+}
+
+func ResourceIBMISLBPoolMembersValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolMemberWeight,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "100"})
+
+	ibmISLBPoolMembersResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool_members", Schema: validateSchema}
+	return &ibmISLBPoolMembersResourceValidator
+}
+
+func resourceIBMISLBPoolMembersCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbID := d.Get(isLBID).(string)
+	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = lbpMembersReplace(context, meta, lbID, lbPoolID, d.Get(isLBPoolMembers).(*schema.Set).List(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", lbID, lbPoolID))
+	return resourceIBMISLBPoolMembersRead(context, d, meta)
+}
+
+// lbpMembersReplace replaces all the members of the pool in one call, and
+// waits for the load balancer to be active once.
+func lbpMembersReplace(context context.Context, meta interface{}, lbID, lbPoolID string, members []interface{}, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	prototypes, err := expandLBPoolMemberPrototypes(members)
+	if err != nil {
+		return err
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	conns.IbmMutexKV.Lock(isLBKey)
+	defer conns.IbmMutexKV.Unlock(isLBKey)
+
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+
+	options := &vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+		Members:        prototypes,
+	}
+	_, response, err := sess.ReplaceLoadBalancerPoolMembersWithContext(context, options)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error replacing the members of load balancer pool (%s): %s\n%s", lbPoolID, err, response)
+	}
+	log.Printf("[INFO] Replaced the members of load balancer pool %s with %d members", lbPoolID, len(prototypes))
+
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+	return nil
+}
+
+func expandLBPoolMemberPrototypes(members []interface{}) ([]vpcv1.LoadBalancerPoolMemberPrototype, error) {
+	prototypes := make([]vpcv1.LoadBalancerPoolMemberPrototype, 0, len(members))
+	for _, memberIntf := range members {
+		member := memberIntf.(map[string]interface{})
+		port := int64(member[isLBPoolMemberPort].(int))
+		weight := int64(member[isLBPoolMemberWeight].(int))
+		address := member[isLBPoolMemberTargetAddress].(string)
+		targetID := member[isLBPoolMemberTargetID].(string)
+		target := &vpcv1.LoadBalancerPoolMemberTargetPrototype{}
+		switch {
+		case address != "" && targetID != "":
+			return nil, fmt.Errorf("[ERROR] The member on port %d has both a target_address and a target_id", port)
+		case address != "":
+			target.Address = &address
+		case targetID != "":
+			target.ID = &targetID
+		default:
+			return nil, fmt.Errorf("[ERROR] The member on port %d needs a target_address or a target_id", port)
+		}
+		prototypes = append(prototypes, vpcv1.LoadBalancerPoolMemberPrototype{
+			Port:   &port,
+			Target: target,
+			Weight: &weight,
+		})
+	}
+	return prototypes, nil
+}
+
+func resourceIBMISLBPoolMembersRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] The id should contain loadbalancer Id and loadbalancer pool Id"))
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+	}
+	memberCollection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, listLoadBalancerPoolMembersOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the members of load balancer pool (%s): %s\n%s", lbPoolID, err, response))
+	}
+
+	members := make([]map[string]interface{}, 0, len(memberCollection.Members))
+	for _, lbPoolMem := range memberCollection.Members {
+		member := map[string]interface{}{
+			isLBPoolMemberPort:   int(*lbPoolMem.Port),
+			isLBPoolMemberWeight: int(*lbPoolMem.Weight),
+		}
+		if target, ok := lbPoolMem.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
+			if target.Address != nil {
+				member[isLBPoolMemberTargetAddress] = *target.Address
+			}
+			if target.ID != nil {
+				member[isLBPoolMemberTargetID] = *target.ID
+			}
+		}
+		members = append(members, member)
+	}
+
+	d.Set(isLBID, lbID)
+	d.Set(isLBPoolID, lbPoolID)
+	if err = d.Set(isLBPoolMembers, members); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting members: %s", err))
+	}
+
+	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
+		ID: &lbID,
+	}
+	lb, response, err := sess.GetLoadBalancerWithContext(context, getLoadBalancerOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response))
+	}
+	d.Set(flex.RelatedCRN, *lb.CRN)
+	return nil
+}
+
+func resourceIBMISLBPoolMembersUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isLBPoolMembers) {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = lbpMembersReplace(context, meta, parts[0], parts[1], d.Get(isLBPoolMembers).(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMISLBPoolMembersRead(context, d, meta)
+}
+
+func resourceIBMISLBPoolMembersDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	getLoadBalancerPoolOptions := &vpcv1.GetLoadBalancerPoolOptions{
+		LoadBalancerID: &lbID,
+		ID:             &lbPoolID,
+	}
+	_, response, err := sess.GetLoadBalancerPoolWithContext(context, getLoadBalancerPoolOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Load Balancer Pool: %s\n%s", err, response))
+	}
+	err = lbpMembersReplace(context, meta, lbID, lbPoolID, []interface{}{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
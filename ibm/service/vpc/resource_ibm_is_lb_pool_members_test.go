@@ -0,0 +1,301 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/fakevpc"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISLBPoolMembers_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbpms-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpms-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpools%d", acctest.RandIntRange(10, 100))
+	node := "ibm_is_lb_pool_members.testacc_lb_mems"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBPoolMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, `
+		members {
+			port           = 8080
+			target_address = "127.0.0.1"
+		}
+		members {
+			port           = 8080
+			target_address = "127.0.0.2"
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBPoolMembersCount(node, 2),
+					resource.TestCheckResourceAttr(node, "members.#", "2"),
+					resource.TestCheckResourceAttrSet(node, "related_crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, `
+		members {
+			port           = 8080
+			target_address = "127.0.0.1"
+			weight         = 20
+		}
+		members {
+			port           = 9000
+			target_address = "127.0.0.3"
+		}
+		members {
+			port           = 9000
+			target_address = "127.0.0.4"
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBPoolMembersCount(node, 3),
+					resource.TestCheckResourceAttr(node, "members.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(node, "members.*", map[string]string{
+						"port":           "8080",
+						"target_address": "127.0.0.1",
+						"weight":         "20",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(node, "members.*", map[string]string{
+						"port":           "9000",
+						"target_address": "127.0.0.4",
+						"weight":         "50",
+					}),
+				),
+			},
+			{
+				ResourceName:      node,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIBMISLBPoolMembers_fakeAPI(t *testing.T) {
+	t.Parallel()
+	server := fakevpc.NewServer()
+	defer server.Close()
+	meta := server.ClientSession()
+	sess, err := meta.VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcResult, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("tf-fake-vpc")})
+	if err != nil {
+		t.Fatalf("Error creating the VPC: %s", err)
+	}
+	subnet, _, err := sess.CreateSubnet(&vpcv1.CreateSubnetOptions{
+		SubnetPrototype: &vpcv1.SubnetPrototype{
+			VPC:           &vpcv1.VPCIdentity{ID: vpcResult.ID},
+			Ipv4CIDRBlock: core.StringPtr("10.240.0.0/24"),
+			Zone:          &vpcv1.ZoneIdentity{Name: core.StringPtr("us-south-1")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the subnet: %s", err)
+	}
+	lb, _, err := sess.CreateLoadBalancer(&vpcv1.CreateLoadBalancerOptions{
+		IsPublic: core.BoolPtr(true),
+		Name:     core.StringPtr("tf-fake-lb"),
+		Subnets:  []vpcv1.SubnetIdentityIntf{&vpcv1.SubnetIdentity{ID: subnet.ID}},
+		Pools: []vpcv1.LoadBalancerPoolPrototype{{
+			Name:      core.StringPtr("tf-fake-pool"),
+			Algorithm: core.StringPtr("round_robin"),
+			Protocol:  core.StringPtr("http"),
+			HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPrototype{
+				Delay: core.Int64Ptr(5), MaxRetries: core.Int64Ptr(2), Timeout: core.Int64Ptr(2), Type: core.StringPtr("http"),
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the load balancer: %s", err)
+	}
+	lbID, poolID := *lb.ID, *lb.Pools[0].ID
+	listMembers := func() []vpcv1.LoadBalancerPoolMember {
+		t.Helper()
+		collection, _, err := sess.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{LoadBalancerID: &lbID, PoolID: &poolID})
+		if err != nil {
+			t.Fatalf("Error listing the members: %s", err)
+		}
+		return collection.Members
+	}
+
+	member := func(address string, weight int) map[string]interface{} {
+		return map[string]interface{}{"port": 8080, "target_address": address, "weight": weight}
+	}
+	r := vpc.ResourceIBMISLBPoolMembers()
+	config := map[string]interface{}{
+		"lb":      lbID,
+		"pool":    poolID,
+		"members": []interface{}{member("10.240.0.4", 50), member("10.240.0.5", 20)},
+	}
+	d := fakeResourceData(t, r, time.Minute, config)
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Error creating the pool members: %v", diags)
+	}
+	if d.Id() != lbID+"/"+poolID {
+		t.Errorf("Expected the ID to be the load balancer and pool IDs, got %q", d.Id())
+	}
+	if members := listMembers(); len(members) != 2 {
+		t.Fatalf("Expected the pool to have 2 members, got %d", len(members))
+	}
+	if d.Get("members.#") != 2 || d.Get("related_crn") != *lb.CRN {
+		t.Errorf("Unexpected state members=%v related_crn=%v", d.Get("members"), d.Get("related_crn"))
+	}
+
+	// Members replaced out of band show as drift
+	_, _, err = sess.ReplaceLoadBalancerPoolMembers(&vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &poolID,
+		Members: []vpcv1.LoadBalancerPoolMemberPrototype{{
+			Port:   core.Int64Ptr(8080),
+			Target: &vpcv1.LoadBalancerPoolMemberTargetPrototype{Address: core.StringPtr("10.240.0.9")},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Error replacing the members out of band: %s", err)
+	}
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Error reading the pool members: %v", diags)
+	}
+	members := d.Get("members").(*schema.Set).List()
+	if len(members) != 1 || members[0].(map[string]interface{})["target_address"] != "10.240.0.9" {
+		t.Errorf("Expected the out of band member, got %v", members)
+	}
+
+	// and the next apply replaces all the members in one call
+	d = fakePlannedResourceData(t, r, d, config)
+	before := len(server.Requests())
+	if diags := r.UpdateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Error updating the pool members: %v", diags)
+	}
+	replaced := 0
+	for _, request := range server.Requests()[before:] {
+		if strings.HasPrefix(request, http.MethodPost) || strings.HasPrefix(request, http.MethodDelete) {
+			t.Errorf("Expected the members to be replaced, got %s", request)
+		}
+		if request == fmt.Sprintf("PUT /load_balancers/%s/pools/%s/members", lbID, poolID) {
+			replaced++
+		}
+	}
+	if replaced != 1 {
+		t.Errorf("Expected one replace of the members, got %d in %v", replaced, server.Requests()[before:])
+	}
+	addresses := map[string]int64{}
+	for _, m := range listMembers() {
+		addresses[*m.Target.(*vpcv1.LoadBalancerPoolMemberTarget).Address] = *m.Weight
+	}
+	if len(addresses) != 2 || addresses["10.240.0.4"] != 50 || addresses["10.240.0.5"] != 20 {
+		t.Errorf("Expected the configured members, got %v", addresses)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("Error deleting the pool members: %v", diags)
+	}
+	if members := listMembers(); len(members) != 0 {
+		t.Errorf("Expected the members to be removed, got %d", len(members))
+	}
+}
+
+func testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, zone, cidr, name, poolName, members string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+	}
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name           = "%s"
+		lb             = ibm_is_lb.testacc_LB.id
+		algorithm      = "weighted_round_robin"
+		protocol       = "http"
+		health_delay   = 45
+		health_retries = 5
+		health_timeout = 30
+		health_type    = "tcp"
+	}
+	resource "ibm_is_lb_pool_members" "testacc_lb_mems" {
+		lb   = ibm_is_lb.testacc_LB.id
+		pool = ibm_is_lb_pool.testacc_lb_pool.pool_id
+		%s
+	}`, vpcname, subnetname, zone, cidr, name, poolName, members)
+}
+
+func testAccCheckIBMISLBPoolMembersDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_lb_pool_members" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &parts[0],
+			PoolID:         &parts[1],
+		}
+		members, _, err := sess.ListLoadBalancerPoolMembers(listLoadBalancerPoolMembersOptions)
+		if err == nil && len(members.Members) != 0 {
+			return fmt.Errorf("LB Pool members still exist: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISLBPoolMembersCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		parts := strings.Split(rs.Primary.ID, "/")
+		if len(parts) != 2 {
+			return fmt.Errorf("Unexpected ID %s", rs.Primary.ID)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &parts[0],
+			PoolID:         &parts[1],
+		}
+		members, _, err := sess.ListLoadBalancerPoolMembers(listLoadBalancerPoolMembersOptions)
+		if err != nil {
+			return err
+		}
+		if len(members.Members) != count {
+			return fmt.Errorf("Expected %d members in pool %s, got %d", count, parts[1], len(members.Members))
+		}
+		return nil
+	}
+}
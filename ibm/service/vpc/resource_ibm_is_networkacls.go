@@ -45,6 +45,7 @@ const (
 	isNetworkACLResourceGroup     = "resource_group"
 	isNetworkACLTags              = "tags"
 	isNetworkACLCRN               = "crn"
+	isNetworkACLRuleAnalysis      = "rule_analysis"
 )
 
 func ResourceIBMISNetworkACL() *schema.Resource {
@@ -63,7 +64,10 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISNetworkACLRuleAnalysisCustomizeDiff(diff)
 			},
 		),
 
@@ -96,12 +100,19 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			isNetworkACLCRN: {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The crn of the resource",
 			},
+			isNetworkACLRuleAnalysis: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules which are shadowed, redundant or contradictory",
+				Elem:        isNetworkACLAnalysisFindingResource(),
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -428,7 +439,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	nwacl, response, err := sess.CreateNetworkACL(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "CreateNetworkACL", err, response)
 	}
 	d.SetId(*nwacl.ID)
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
@@ -445,7 +456,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
+	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *nwacl.CRN)
 		if err != nil {
@@ -479,7 +490,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Network ACL(%s) : %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetNetworkACL", err, response)
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
@@ -493,6 +504,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource network acl (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isNetworkACLTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
 	rules := make([]interface{}, 0)
 	if len(nwacl.Rules) > 0 {
@@ -575,6 +587,20 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 	}
 	d.Set(isNetworkACLRules, rules)
+	analysisRules := make([]*isNetworkACLAnalysisRule, 0, len(nwacl.Rules))
+	for _, rulex := range nwacl.Rules {
+		rule, err := isNetworkACLAnalysisRuleFromItem(rulex)
+		if err != nil {
+			log.Printf("[WARN] %s", err)
+			analysisRules = nil
+			break
+		}
+		analysisRules = append(analysisRules, rule)
+	}
+	if analysisRules != nil {
+		findings, _ := isNetworkACLAnalyze(analysisRules)
+		d.Set(isNetworkACLRuleAnalysis, isNetworkACLAnalysisFlattenFindings(findings))
+	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -623,10 +649,10 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 		_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+			return flex.NewAPIError("vpc", "UpdateNetworkACL", err, response)
 		}
 	}
-	if d.HasChange(isNetworkACLTags) {
+	if d.HasChange(isNetworkACLTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string))
 		if err != nil {
@@ -680,7 +706,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Network ACL (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetNetworkACL", err, response)
 	}
 
 	deleteNetworkAclOptions := &vpcv1.DeleteNetworkACLOptions{
@@ -688,7 +714,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Network ACL : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeleteNetworkACL", err, response)
 	}
 	d.SetId("")
 	return nil
@@ -718,6 +744,28 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 	return true, nil
 }
 
+// resourceIBMISNetworkACLRuleAnalysisCustomizeDiff plans the rule_analysis of
+// the planned rules, and warns about the rules which never match.
+func resourceIBMISNetworkACLRuleAnalysisCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" && !diff.HasChange(isNetworkACLRules) {
+		return nil
+	}
+	if !diff.NewValueKnown(isNetworkACLRules) {
+		return diff.SetNewComputed(isNetworkACLRuleAnalysis)
+	}
+	rules, err := isNetworkACLAnalysisRulesFromList(diff.Get(isNetworkACLRules).([]interface{}))
+	if err != nil {
+		// The rules are validated on apply
+		log.Printf("[WARN] %s", err)
+		return diff.SetNewComputed(isNetworkACLRuleAnalysis)
+	}
+	findings, _ := isNetworkACLAnalyze(rules)
+	for _, finding := range findings {
+		log.Printf("[WARN] Network ACL %s rule: %s", finding.kind, finding.message)
+	}
+	return diff.SetNew(isNetworkACLRuleAnalysis, isNetworkACLAnalysisFlattenFindings(findings))
+}
+
 func checkNetworkACLNil(ptr *int64) int {
 	if ptr == nil {
 		return 0
@@ -737,7 +785,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		}
 		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "ListNetworkACLRules", err, response)
 		}
 		start = flex.GetNext(rawrules.Next)
 		allrecs = append(allrecs, rawrules.Rules...)
@@ -764,7 +812,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 
 		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "DeleteNetworkACLRule", err, response)
 		}
 	}
 	return nil
@@ -894,7 +942,7 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 		}
 		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "CreateNetworkACLRule", err, response)
 		}
 	}
 	return nil
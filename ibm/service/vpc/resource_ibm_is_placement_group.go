@@ -50,7 +50,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": flex.TagsAllSchema(),
 			isPlacementGroupAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -184,7 +185,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	placementGroup, response, err := vpcClient.CreatePlacementGroupWithContext(context, createPlacementGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreatePlacementGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return flex.APIErrorDiagnostics("vpc", "CreatePlacementGroup", err, response)
 	}
 
 	d.SetId(*placementGroup.ID)
@@ -193,7 +194,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
-	if _, ok := d.GetOk(isPlacementGroupTags); ok {
+	if _, ok := d.GetOk(isPlacementGroupTags); ok || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
@@ -232,7 +233,7 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetPlacementGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return flex.APIErrorDiagnostics("vpc", "GetPlacementGroup", err, response)
 	}
 
 	if err = d.Set("strategy", placementGroup.Strategy); err != nil {
@@ -274,6 +275,7 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 	}
 
 	d.Set(isPlacementGroupTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isPlacementGroupAccessTags, accesstags)
 	return nil
 }
@@ -306,10 +308,10 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 		_, response, err := vpcClient.UpdatePlacementGroupWithContext(context, updatePlacementGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdatePlacementGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
+			return flex.APIErrorDiagnostics("vpc", "UpdatePlacementGroup", err, response)
 		}
 	}
-	if d.HasChange(isPlacementGroupTags) {
+	if d.HasChange(isPlacementGroupTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
@@ -341,13 +343,13 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 
 	response, err := vpcClient.DeletePlacementGroupWithContext(context, deletePlacementGroupOptions)
 	if err != nil {
-		if response.StatusCode == 409 {
+		if response != nil && response.StatusCode == 409 {
 			_, err = isWaitForPlacementGroupDeleteRetry(vpcClient, d, d.Id())
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s", err))
 			}
 		} else {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s\n%s", err, response))
+			return flex.APIErrorDiagnostics("vpc", "DeletePlacementGroup", err, response)
 		}
 	}
 	_, err = isWaitForPlacementGroupDelete(vpcClient, d, d.Id())
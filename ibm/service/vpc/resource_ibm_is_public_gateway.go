@@ -51,7 +51,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -107,6 +107,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Service tags for the public gateway instance",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -216,7 +217,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Public Gateway %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "CreatePublicGateway", err, response)
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
@@ -227,7 +228,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
@@ -286,7 +287,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "GetPublicGateway", err, response)
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -306,6 +307,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			"Error on get of vpc public gateway (%s) tags: %s", id, err)
 	}
 	d.Set(isPublicGatewayTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -336,13 +338,13 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isPublicGatewayName).(string)
 		hasChanged = true
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if d.HasChange(isPublicGatewayTags) || d.HasChange("tags_all") {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "GetPublicGateway", err, response)
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
@@ -365,7 +367,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Public Gateway  : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "UpdatePublicGateway", err, response)
 		}
 	}
 	return resourceIBMISPublicGatewayRead(d, meta)
@@ -387,7 +389,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetPublicGateway", err, response)
 	}
 
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
@@ -395,7 +397,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 	}
 	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Public Gateway : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeletePublicGateway", err, response)
 	}
 	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
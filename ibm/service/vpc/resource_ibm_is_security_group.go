@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"reflect"
+	"strings"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +26,9 @@ const (
 	isSecurityGroupResourceGroup = "resource_group"
 	isSecurityGroupTags          = "tags"
 	isSecurityGroupCRN           = "crn"
+
+	isSecurityGroupRuleProtocolAll   = "all"
+	isSecurityGroupRuleRemoteDefault = "0.0.0.0/0"
 )
 
 func ResourceIBMISSecurityGroup() *schema.Resource {
@@ -38,7 +43,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -66,6 +71,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			isSecurityGroupCRN: {
 				Type:        schema.TypeString,
@@ -75,8 +81,10 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 
 			isSecurityGroupRules: {
 				Type:        schema.TypeList,
+				Optional:    true,
 				Computed:    true,
-				Description: "Security Rules",
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "Security Rules. When set, the rules of the security group are managed by this resource: missing rules are added and the others removed",
 				Elem: &schema.Resource{
 					Schema: makeIBMISSecurityRuleSchema(),
 				},
@@ -140,6 +148,14 @@ func ResourceIBMISSecurityGroupValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isSecurityGroupRuleProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "all, icmp, tcp, udp"})
+
 	ibmISSecurityGroupResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group", Schema: validateSchema}
 	return &ibmISSecurityGroupResourceValidator
 }
@@ -169,11 +185,19 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "CreateSecurityGroup", err, response)
 	}
 	d.SetId(*sg.ID)
+	// The rules are only reconciled when set, a new security group comes with
+	// default rules
+	if securityGroupRulesConfigured(d) {
+		err = reconcileSecurityGroupRules(d, sess, *sg.ID)
+		if err != nil {
+			return err
+		}
+	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *sg.CRN)
 		if err != nil {
@@ -200,7 +224,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "GetSecurityGroup", err, response)
 	}
 	tags, err := flex.GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -208,97 +232,11 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 			"Error getting Security Group tags : %s\n%s", d.Id(), err)
 	}
 	d.Set(isSecurityGroupTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isSecurityGroupCRN, *group.CRN)
 	d.Set(isSecurityGroupName, *group.Name)
 	d.Set(isSecurityGroupVPC, *group.VPC.ID)
-	rules := make([]map[string]interface{}, 0)
-	if len(group.Rules) > 0 {
-		for _, rule := range group.Rules {
-			switch reflect.TypeOf(rule).String() {
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
-					r := make(map[string]interface{})
-					if rule.Code != nil {
-						r[isSecurityGroupRuleCode] = int(*rule.Code)
-					}
-					if rule.Type != nil {
-						r[isSecurityGroupRuleType] = int(*rule.Type)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
-					r := make(map[string]interface{})
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
-					r := make(map[string]interface{})
-					if rule.PortMin != nil {
-						r[isSecurityGroupRulePortMin] = int(*rule.PortMin)
-					}
-					if rule.PortMax != nil {
-						r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			}
-		}
-	}
+	rules := flattenSecurityGroupRules(group.Rules, d.Get(isSecurityGroupRules).([]interface{}))
 	d.Set(isSecurityGroupRules, rules)
 	d.SetId(*group.ID)
 	if group.ResourceGroup != nil {
@@ -324,7 +262,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	name := ""
 	hasChanged := false
 
-	if d.HasChange(isSecurityGroupTags) {
+	if d.HasChange(isSecurityGroupTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string))
 		if err != nil {
@@ -336,8 +274,6 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange(isSecurityGroupName) {
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
-	} else {
-		return resourceIBMISSecurityGroupRead(d, meta)
 	}
 
 	if hasChanged {
@@ -354,7 +290,14 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "UpdateSecurityGroup", err, response)
+		}
+	}
+
+	if d.HasChange(isSecurityGroupRules) {
+		err = reconcileSecurityGroupRules(d, sess, id)
+		if err != nil {
+			return err
 		}
 	}
 	return resourceIBMISSecurityGroupRead(d, meta)
@@ -376,7 +319,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetSecurityGroup", err, response)
 	}
 
 	start := ""
@@ -387,7 +330,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 		if err != nil || groups == nil {
-			return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "ListSecurityGroupTargets", err, response)
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -410,7 +353,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(id, *securityGroupTargetReference.ID)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Security Group Targets : %s\n%s", err, response)
+					return flex.NewAPIError("vpc", "DeleteSecurityGroupTargetBinding", err, response)
 				}
 
 			}
@@ -422,7 +365,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	}
 	response, err = sess.DeleteSecurityGroup(deleteSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Security Group : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeleteSecurityGroup", err, response)
 	}
 	d.SetId("")
 	return nil
@@ -452,46 +395,320 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 
 		isSecurityGroupRuleDirection: {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "Direction of traffic to enforce, either inbound or outbound",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
 		},
 
 		isSecurityGroupRuleIPVersion: {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "IP version: ipv4",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      isSecurityGroupRuleIPVersionDefault,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+			Description:  "IP version: ipv4",
 		},
 
 		isSecurityGroupRuleRemote: {
 			Type:        schema.TypeString,
-			Computed:    true,
+			Optional:    true,
+			Default:     isSecurityGroupRuleRemoteDefault,
 			Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
 		},
 
 		isSecurityGroupRuleType: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+			Description:  "The ICMP traffic type to allow, all the types if not set",
 		},
 
 		isSecurityGroupRuleCode: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+			Description:  "The ICMP traffic code to allow, all the codes if not set",
 		},
 
 		isSecurityGroupRulePortMin: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+			Description:  "The inclusive lower bound of the TCP or UDP port range, 1 if not set",
 		},
 
 		isSecurityGroupRulePortMax: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+			Description:  "The inclusive upper bound of the TCP or UDP port range, 65535 if not set",
 		},
 
 		isSecurityGroupRuleProtocol: {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      isSecurityGroupRuleProtocolAll,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group", isSecurityGroupRuleProtocol),
+			Description:  "The protocol to enforce: all, icmp, tcp or udp",
 		},
 	}
 }
+
+// securityGroupRuleKey identifies a rule by what it allows, two rules with
+// the same key are the same rule. The unset ports are normalized to the values
+// used by the API.
+func securityGroupRuleKey(rule map[string]interface{}) string {
+	ipVersion, _ := rule[isSecurityGroupRuleIPVersion].(string)
+	if ipVersion == "" {
+		ipVersion = isSecurityGroupRuleIPVersionDefault
+	}
+	protocol, _ := rule[isSecurityGroupRuleProtocol].(string)
+	if protocol == "" {
+		protocol = isSecurityGroupRuleProtocolAll
+	}
+	remote, _ := rule[isSecurityGroupRuleRemote].(string)
+	if remote == "" {
+		remote = isSecurityGroupRuleRemoteDefault
+	}
+	key := fmt.Sprintf("%s/%s/%s/%s", rule[isSecurityGroupRuleDirection], strings.ToLower(ipVersion), protocol, remote)
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		icmpType, _ := rule[isSecurityGroupRuleType].(int)
+		icmpCode, _ := rule[isSecurityGroupRuleCode].(int)
+		key += fmt.Sprintf("/%d/%d", icmpType, icmpCode)
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		portMin, _ := rule[isSecurityGroupRulePortMin].(int)
+		portMax, _ := rule[isSecurityGroupRulePortMax].(int)
+		portMin, portMax = securityGroupRulePorts(portMin, portMax)
+		key += fmt.Sprintf("/%d/%d", portMin, portMax)
+	}
+	return key
+}
+
+// securityGroupRulePorts returns the port range of a tcp or udp rule, 0 is
+// unset. If only min or max is set, both are set to the same value.
+func securityGroupRulePorts(portMin, portMax int) (int, int) {
+	switch {
+	case portMin == 0 && portMax == 0:
+		return 1, 65535
+	case portMin == 0:
+		return portMax, portMax
+	case portMax == 0:
+		return portMin, portMin
+	}
+	return portMin, portMax
+}
+
+// flattenSecurityGroupRule returns the id of the rule and its representation
+// in the rules of the security group.
+func flattenSecurityGroupRule(rule vpcv1.SecurityGroupRuleIntf) (string, map[string]interface{}) {
+	r := make(map[string]interface{})
+	var id string
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	switch rule := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id = *rule.ID
+		if rule.Code != nil {
+			r[isSecurityGroupRuleCode] = int(*rule.Code)
+		}
+		if rule.Type != nil {
+			r[isSecurityGroupRuleType] = int(*rule.Type)
+		}
+		r[isSecurityGroupRuleDirection] = *rule.Direction
+		r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+		if rule.Protocol != nil {
+			r[isSecurityGroupRuleProtocol] = *rule.Protocol
+		}
+		remote = rule.Remote
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		id = *rule.ID
+		r[isSecurityGroupRuleDirection] = *rule.Direction
+		r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+		if rule.Protocol != nil {
+			r[isSecurityGroupRuleProtocol] = *rule.Protocol
+		}
+		remote = rule.Remote
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id = *rule.ID
+		if rule.PortMin != nil {
+			r[isSecurityGroupRulePortMin] = int(*rule.PortMin)
+		}
+		if rule.PortMax != nil {
+			r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
+		}
+		r[isSecurityGroupRuleDirection] = *rule.Direction
+		r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+		if rule.Protocol != nil {
+			r[isSecurityGroupRuleProtocol] = *rule.Protocol
+		}
+		remote = rule.Remote
+	default:
+		return "", nil
+	}
+	if remote, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			r[isSecurityGroupRuleRemote] = *remote.ID
+		} else if remote.Address != nil {
+			r[isSecurityGroupRuleRemote] = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			r[isSecurityGroupRuleRemote] = *remote.CIDRBlock
+		}
+	}
+	return id, r
+}
+
+// flattenSecurityGroupRules returns the rules of the security group. The rules
+// matching one of the prior rules keep their prior representation and order,
+// so that only the rules added or removed outside of terraform show in plan.
+func flattenSecurityGroupRules(live []vpcv1.SecurityGroupRuleIntf, prior []interface{}) []map[string]interface{} {
+	unmatched := make([]map[string]interface{}, 0, len(live))
+	for _, rule := range live {
+		if _, r := flattenSecurityGroupRule(rule); r != nil {
+			unmatched = append(unmatched, r)
+		}
+	}
+	rules := make([]map[string]interface{}, 0, len(unmatched))
+	for _, p := range prior {
+		priorRule, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := securityGroupRuleKey(priorRule)
+		for i, r := range unmatched {
+			if securityGroupRuleKey(r) == key {
+				rules = append(rules, priorRule)
+				unmatched = append(unmatched[:i], unmatched[i+1:]...)
+				break
+			}
+		}
+	}
+	return append(rules, unmatched...)
+}
+
+// securityGroupRulePrototype returns the prototype creating rule.
+func securityGroupRulePrototype(rule map[string]interface{}) (*vpcv1.SecurityGroupRulePrototype, error) {
+	direction := rule[isSecurityGroupRuleDirection].(string)
+	ipVersion := rule[isSecurityGroupRuleIPVersion].(string)
+	protocol := rule[isSecurityGroupRuleProtocol].(string)
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &direction,
+		IPVersion: &ipVersion,
+		Protocol:  &protocol,
+	}
+
+	icmpType := rule[isSecurityGroupRuleType].(int)
+	icmpCode := rule[isSecurityGroupRuleCode].(int)
+	portMin := rule[isSecurityGroupRulePortMin].(int)
+	portMax := rule[isSecurityGroupRulePortMax].(int)
+	if protocol != isSecurityGroupRuleProtocolICMP && (icmpType != 0 || icmpCode != 0) {
+		return nil, fmt.Errorf("[ERROR] The %s rule %s: type and code are only supported by the icmp rules", direction, securityGroupRuleKey(rule))
+	}
+	if protocol != isSecurityGroupRuleProtocolTCP && protocol != isSecurityGroupRuleProtocolUDP && (portMin != 0 || portMax != 0) {
+		return nil, fmt.Errorf("[ERROR] The %s rule %s: port_min and port_max are only supported by the tcp and udp rules", direction, securityGroupRuleKey(rule))
+	}
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		if icmpCode != 0 && icmpType == 0 {
+			return nil, fmt.Errorf("[ERROR] The %s rule %s: icmp code requires icmp type", direction, securityGroupRuleKey(rule))
+		}
+		if icmpType != 0 {
+			prototype.Type = core.Int64Ptr(int64(icmpType))
+		}
+		if icmpCode != 0 {
+			prototype.Code = core.Int64Ptr(int64(icmpCode))
+		}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		portMin, portMax = securityGroupRulePorts(portMin, portMax)
+		prototype.PortMin = core.Int64Ptr(int64(portMin))
+		prototype.PortMax = core.Int64Ptr(int64(portMax))
+	}
+
+	address, cidr, id, err := inferRemoteSecurityGroup(rule[isSecurityGroupRuleRemote].(string))
+	if err != nil {
+		return nil, err
+	}
+	remote := &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if address != "" {
+		remote.Address = &address
+	} else if cidr != "" {
+		remote.CIDRBlock = &cidr
+	} else {
+		remote.ID = &id
+	}
+	prototype.Remote = remote
+	return prototype, nil
+}
+
+// securityGroupRulesConfigured reports whether rules is set in the
+// configuration, `rules = []` included.
+func securityGroupRulesConfigured(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		_, ok := d.GetOk(isSecurityGroupRules)
+		return ok
+	}
+	return !config.GetAttr(isSecurityGroupRules).IsNull()
+}
+
+// reconcileSecurityGroupRules makes the rules of the security group match the
+// configured rules. The missing rules are created before the others are
+// deleted, so that the traffic allowed by both is never interrupted.
+func reconcileSecurityGroupRules(d *schema.ResourceData, sess *vpcv1.VpcV1, id string) error {
+	isSecurityGroupRuleKey := "security_group_rule_key_" + id
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	}
+	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		return flex.NewAPIError("vpc", "GetSecurityGroup", err, response)
+	}
+	liveRules := make(map[string][]string)
+	for _, rule := range group.Rules {
+		ruleID, r := flattenSecurityGroupRule(rule)
+		if r != nil {
+			key := securityGroupRuleKey(r)
+			liveRules[key] = append(liveRules[key], ruleID)
+		}
+	}
+
+	for _, v := range d.Get(isSecurityGroupRules).([]interface{}) {
+		rule, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := securityGroupRuleKey(rule)
+		if ids := liveRules[key]; len(ids) > 0 {
+			liveRules[key] = ids[1:]
+			continue
+		}
+		prototype, err := securityGroupRulePrototype(rule)
+		if err != nil {
+			return err
+		}
+		options := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &id,
+			SecurityGroupRulePrototype: prototype,
+		}
+		_, response, err := sess.CreateSecurityGroupRule(options)
+		if err != nil {
+			return flex.NewAPIError("vpc", "CreateSecurityGroupRule", err, response)
+		}
+	}
+
+	for _, ids := range liveRules {
+		for _, ruleID := range ids {
+			deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+				SecurityGroupID: &id,
+				ID:              &ruleID,
+			}
+			response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return flex.NewAPIError("vpc", "DeleteSecurityGroupRule", err, response)
+			}
+		}
+	}
+	return nil
+}
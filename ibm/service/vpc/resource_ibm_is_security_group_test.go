@@ -4,16 +4,22 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/fakevpc"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -53,6 +59,147 @@ func TestAccIBMISSecurityGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISSecurityGroup_rules(t *testing.T) {
+	var securityGroup string
+
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-rules-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupConfigRules(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_security_group.testacc_security_group", securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.0.port_min", "22"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.1.protocol", "icmp"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupConfigNoRules(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestIBMISSecurityGroup_fakeAPIRules(t *testing.T) {
+	t.Parallel()
+	server := fakevpc.NewServer()
+	defer server.Close()
+	meta := server.ClientSession()
+	sess, err := meta.VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcResult, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("tf-fake-vpc")})
+	if err != nil {
+		t.Fatalf("Error creating the VPC: %s", err)
+	}
+
+	ssh := map[string]interface{}{
+		"direction":  "inbound",
+		"ip_version": "ipv4",
+		"remote":     "10.0.0.0/8",
+		"protocol":   "tcp",
+		"port_min":   22,
+		"port_max":   22,
+	}
+	outbound := map[string]interface{}{
+		"direction":  "outbound",
+		"ip_version": "ipv4",
+		"remote":     "0.0.0.0/0",
+		"protocol":   "all",
+	}
+	r := vpc.ResourceIBMISSecurityGroup()
+	config := map[string]interface{}{
+		"name":  "tf-fake-sg",
+		"vpc":   *vpcResult.ID,
+		"rules": []interface{}{ssh, outbound},
+	}
+	d := fakeResourceData(t, r, time.Minute, config)
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("Error creating the security group: %s", err)
+	}
+	if rules := server.Get(fakevpc.SecurityGroups, d.Id())["rules"].([]interface{}); len(rules) != 2 {
+		t.Fatalf("Expected the security group to have 2 rules, got %v", rules)
+	}
+	if d.Get("rules.#") != 2 || d.Get("rules.0.port_min") != 22 || d.Get("rules.1.direction") != "outbound" {
+		t.Errorf("Expected the configured rules in order, got %v", d.Get("rules"))
+	}
+
+	// A rule added out of band shows as drift
+	_, _, err = sess.CreateSecurityGroupRule(&vpcv1.CreateSecurityGroupRuleOptions{
+		SecurityGroupID: core.StringPtr(d.Id()),
+		SecurityGroupRulePrototype: &vpcv1.SecurityGroupRulePrototype{
+			Direction: core.StringPtr("inbound"),
+			Protocol:  core.StringPtr("icmp"),
+			Type:      core.Int64Ptr(8),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the out of band rule: %s", err)
+	}
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("Error reading the security group: %s", err)
+	}
+	if d.Get("rules.#") != 3 || d.Get("rules.2.protocol") != "icmp" || d.Get("rules.2.type") != 8 {
+		t.Errorf("Expected the out of band rule after the configured rules, got %v", d.Get("rules"))
+	}
+
+	// and is removed by the next apply
+	d = fakePlannedResourceData(t, r, d, config)
+	if err := r.Update(d, meta); err != nil {
+		t.Fatalf("Error updating the security group: %s", err)
+	}
+	rules := server.Get(fakevpc.SecurityGroups, d.Id())["rules"].([]interface{})
+	if len(rules) != 2 {
+		t.Fatalf("Expected the out of band rule to be removed, got %v", rules)
+	}
+	for _, rule := range rules {
+		if rule.(map[string]interface{})["protocol"] == "icmp" {
+			t.Errorf("Expected the icmp rule to be removed, got %v", rules)
+		}
+	}
+
+	// An empty list removes all the rules
+	config["rules"] = []interface{}{}
+	d = fakePlannedResourceData(t, r, d, config)
+	if err := r.Update(d, meta); err != nil {
+		t.Fatalf("Error updating the security group: %s", err)
+	}
+	if rules := server.Get(fakevpc.SecurityGroups, d.Id())["rules"].([]interface{}); len(rules) != 0 {
+		t.Errorf("Expected no rules, got %v", rules)
+	}
+}
+
+// fakePlannedResourceData returns the data of the resource planned from the
+// state of d and config, so that the changes show in HasChange.
+func fakePlannedResourceData(t *testing.T, r *schema.Resource, d *schema.ResourceData, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	state := d.State()
+	sm := schema.InternalMap(r.Schema)
+	diff, err := sm.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil, nil, true)
+	if err != nil {
+		t.Fatalf("Error planning the changes: %s", err)
+	}
+	planned, err := sm.Data(state, diff)
+	if err != nil {
+		t.Fatalf("Error planning the changes: %s", err)
+	}
+	return planned
+}
+
 func testAccCheckIBMISSecurityGroupDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -126,3 +273,42 @@ resource "ibm_is_security_group" "testacc_security_group" {
 }`, vpcname, name)
 
 }
+
+func testAccCheckIBMISsecurityGroupConfigRules(vpcname, name string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc = "${ibm_is_vpc.testacc_vpc.id}"
+	rules {
+		direction = "inbound"
+		remote    = "10.0.0.0/8"
+		protocol  = "tcp"
+		port_min  = 22
+		port_max  = 22
+	}
+	rules {
+		direction = "inbound"
+		protocol  = "icmp"
+		type      = 8
+	}
+}`, vpcname, name)
+
+}
+
+func testAccCheckIBMISsecurityGroupConfigNoRules(vpcname, name string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc = "${ibm_is_vpc.testacc_vpc.id}"
+	rules = []
+}`, vpcname, name)
+
+}
@@ -60,7 +60,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -175,23 +175,26 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	options := &vpcv1.CreateSnapshotOptions{}
+	snapshotPrototype := &vpcv1.SnapshotPrototypeSnapshotBySourceVolume{}
 	if snapshotName, ok := d.GetOk(isSnapshotName); ok {
 		name := snapshotName.(string)
-		options.Name = &name
+		snapshotPrototype.Name = &name
 	}
 	if sourceVolume, ok := d.GetOk(isSnapshotSourceVolume); ok {
 		sv := sourceVolume.(string)
-		options.SourceVolume = &vpcv1.VolumeIdentity{
+		snapshotPrototype.SourceVolume = &vpcv1.VolumeIdentity{
 			ID: &sv,
 		}
 	}
 	if grp, ok := d.GetOk(isVPCResourceGroup); ok {
 		rg := grp.(string)
-		options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+		snapshotPrototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
 			ID: &rg,
 		}
 	}
+	options := &vpcv1.CreateSnapshotOptions{
+		SnapshotPrototype: snapshotPrototype,
+	}
 
 	log.Printf("[DEBUG] Snapshot create")
 
@@ -42,7 +42,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -88,6 +88,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for SSH key",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			isKeyResourceGroup: {
 				Type:        schema.TypeString,
@@ -189,13 +190,13 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 
 	key, response, err := sess.CreateKey(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "CreateKey", err, response)
 	}
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
+	if _, ok := d.GetOk(isKeyTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
@@ -231,7 +232,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting SSH Key (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetKey", err, response)
 	}
 	d.Set(isKeyName, *key.Name)
 	d.Set(isKeyPublicKey, *key.PublicKey)
@@ -244,6 +245,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of vpc SSH Key (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isKeyTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -282,13 +284,13 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if d.HasChange(isKeyTags) || d.HasChange("tags_all") {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting SSH Key : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "GetKey", err, response)
 		}
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
@@ -311,7 +313,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		options.KeyPatch = keyPatch
 		_, response, err := sess.UpdateKey(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc SSH Key: %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "UpdateKey", err, response)
 		}
 	}
 	return nil
@@ -341,7 +343,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting SSH Key (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetKey", err, response)
 	}
 
 	options := &vpcv1.DeleteKeyOptions{
@@ -349,7 +351,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteKey(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting SSH Key : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeleteKey", err, response)
 	}
 	d.SetId("")
 	return nil
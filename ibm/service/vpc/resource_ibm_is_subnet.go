@@ -64,7 +64,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -118,6 +118,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			isSubnetAccessTags: {
 				Type:        schema.TypeSet,
@@ -373,7 +374,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
+	if _, ok := d.GetOk(isSubnetTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
@@ -452,7 +453,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetSubnet", err, response)
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIPVersion, *subnet.IPVersion)
@@ -494,6 +495,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 
 	d.Set(isSubnetTags, tags)
+	d.Set("tags_all", tags)
 	d.Set(isSubnetAccessTags, accesstags)
 	d.Set(isSubnetCRN, *subnet.CRN)
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/subnets")
@@ -510,7 +512,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
-	if d.HasChange(isSubnetTags) {
+	if d.HasChange(isSubnetTags) || d.HasChange("tags_all") {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
@@ -566,7 +568,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return flex.NewAPIError("vpc", "UnsetSubnetPublicGateway", err, response)
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -581,7 +583,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return flex.NewAPIError("vpc", "SetSubnetPublicGateway", err, response)
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -617,7 +619,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		updateSubnetOptions.ID = &id
 		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Subnet : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "UpdateSubnet", err, response)
 		}
 	}
 	return nil
@@ -648,7 +650,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetSubnet", err, response)
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
@@ -675,7 +677,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				return fmt.Errorf("[ERROR] Error Deleting Subnet : %s", err)
 			}
 		} else {
-			return fmt.Errorf("[ERROR] Error Deleting Subnet : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "DeleteSubnet", err, response)
 		}
 	}
 	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
@@ -60,7 +60,7 @@ func ResourceIBMISVolume() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -182,6 +182,7 @@ func ResourceIBMISVolume() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the volume instance",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -331,7 +332,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "CreateVolume", err, response)
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
@@ -340,7 +341,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVolumeTags); ok || v != "" {
+	if _, ok := d.GetOk(isVolumeTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isVolumeTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
@@ -375,7 +376,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetVolume", err, response)
 	}
 	d.SetId(*vol.ID)
 	d.Set(isVolumeName, *vol.Name)
@@ -417,6 +418,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc volume (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVolumeTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -466,13 +468,13 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	}
 
 	// tags update
-	if d.HasChange(isVolumeTags) {
+	if d.HasChange(isVolumeTags) || d.HasChange("tags_all") {
 		options := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
 		vol, response, err := sess.GetVolume(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "GetVolume", err, response)
 		}
 		oldList, newList := d.GetChange(isVolumeTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
@@ -511,7 +513,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		vol, response, err := sess.GetVolume(getvoloptions)
 		if err != nil || vol == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volId, err, response)
+			return flex.NewAPIError("vpc", "GetVolume", err, response)
 		}
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) < 1 {
 			return fmt.Errorf("[ERROR] Error updating Volume profile/iops because the specified volume %s is not attached to a virtual server instance ", volId)
@@ -523,7 +525,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		instance, response, err := sess.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the volume (%s) is attached : %s\n%s", insId, volId, err, response)
+			return flex.NewAPIError("vpc", "GetInstance", err, response)
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -533,7 +535,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			_, response, err = sess.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) to which the volume (%s) is attached  : %s\n%s", insId, volId, err, response)
+				return flex.NewAPIError("vpc", "CreateInstanceAction", err, response)
 			}
 			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -578,7 +580,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Volume (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError("vpc", "GetVolume", err, response)
 		}
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) == 0 || *vol.VolumeAttachments[0].ID == "" {
 			return fmt.Errorf("[ERROR] Error volume capacity can't be updated since volume %s is not attached to any instance for VolumePatch", id)
@@ -589,7 +591,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		instance, response, err := sess.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", *insId, err, response)
+			return flex.NewAPIError("vpc", "GetInstance", err, response)
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -599,7 +601,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			_, response, err = sess.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", *insId, err, response)
+				return flex.NewAPIError("vpc", "CreateInstanceAction", err, response)
 			}
 			_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -617,7 +619,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		options.VolumePatch = volumeCapacityPatch
 		_, response, err = sess.UpdateVolume(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc volume: %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "UpdateVolume", err, response)
 		}
 		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -652,7 +654,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetVolume", err, response)
 	}
 
 	if volDetails.VolumeAttachments != nil {
@@ -678,7 +680,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVolume(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Volume : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeleteVolume", err, response)
 	}
 	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -783,7 +785,7 @@ func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
 	delete_all_snapshots.SourceVolumeID = &id
 	response, err := sess.DeleteSnapshots(delete_all_snapshots)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting snapshots from volume %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeleteSnapshots", err, response)
 	}
 	return nil
 }
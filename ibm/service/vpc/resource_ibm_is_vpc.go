@@ -76,7 +76,7 @@ func ResourceIBMISVPC() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -185,6 +185,7 @@ func ResourceIBMISVPC() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": flex.TagsAllSchema(),
 
 			isVPCCRN: {
 				Type:        schema.TypeString,
@@ -476,7 +477,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 
 	vpc, response, err := sess.CreateVPC(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC %s ", flex.BeautifyError(err, response))
+		return flex.NewAPIError("vpc", "CreateVPC", err, response)
 	}
 	d.SetId(*vpc.ID)
 
@@ -498,7 +499,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPCTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
@@ -565,7 +566,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "GetVPC", err, response)
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -597,6 +598,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVPCTags, tags)
+	d.Set("tags_all", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -633,7 +635,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "ListSubnets", err, response)
 		}
 		start = flex.GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -801,13 +803,13 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPCTags) {
+	if d.HasChange(isVPCTags) || d.HasChange("tags_all") {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "GetVPC", err, response)
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
@@ -847,7 +849,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		updateVpcOptions.VPCPatch = vpcPatch
 		_, response, err := sess.UpdateVPC(updateVpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating VPC : %s\n%s", err, response)
+			return flex.NewAPIError("vpc", "UpdateVPC", err, response)
 		}
 	}
 	return nil
@@ -878,7 +880,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "GetVPC", err, response)
 	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
@@ -886,7 +888,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting VPC : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "DeleteVPC", err, response)
 	}
 	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -974,7 +976,7 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
+		return flex.NewAPIError("vpc", "UpdateNetworkACL", err, response)
 	}
 	return nil
 }
@@ -993,7 +995,7 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group name : %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "UpdateSecurityGroup", err, response)
 	}
 	return nil
 }
@@ -1011,7 +1013,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Routing table name %s\n%s", err, response)
+		return flex.NewAPIError("vpc", "UpdateVPCRoutingTable", err, response)
 	}
 	return nil
 }
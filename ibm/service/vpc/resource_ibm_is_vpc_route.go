@@ -144,7 +144,7 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 		VPCID:       &vpcID,
 		Destination: &cidr,
 		Name:        &routeName,
-		NextHop: &vpcv1.RouteNextHopPrototype{
+		NextHop: &vpcv1.RoutePrototypeNextHop{
 			Address: &nextHop,
 		},
 		Zone: &vpcv1.ZoneIdentity{
@@ -6,14 +6,21 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/fakevpc"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -234,3 +241,145 @@ func testAccCheckIBMISVPCSgConfig(vpcname string, sgname string) string {
 `, vpcname, sgname)
 
 }
+
+// fakeResourceData returns the data of a new resource whose create and delete
+// timeouts are timeout.
+func fakeResourceData(t *testing.T, r *schema.Resource, timeout time.Duration, attributes map[string]interface{}) *schema.ResourceData {
+	r.Timeouts = &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(timeout),
+		Update: schema.DefaultTimeout(timeout),
+		Delete: schema.DefaultTimeout(timeout),
+	}
+	d := r.Data(nil)
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("Error setting %s: %s", k, err)
+		}
+	}
+	return d
+}
+
+func TestIBMISVPC_fakeAPI(t *testing.T) {
+	t.Parallel()
+	server := fakevpc.NewServer()
+	defer server.Close()
+	meta := server.ClientSession()
+
+	r := vpc.ResourceIBMISVPC()
+	d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"name": "tf-fake-vpc"})
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("Error creating the VPC: %s", err)
+	}
+	if d.Id() == "" || server.Get(fakevpc.VPCs, d.Id()) == nil {
+		t.Fatalf("Expected the VPC to be created, got ID %q", d.Id())
+	}
+	if d.Get("status") != "available" || d.Get("default_security_group") == "" || d.Get("crn") == "" {
+		t.Errorf("Unexpected state status=%v default_security_group=%v crn=%v", d.Get("status"), d.Get("default_security_group"), d.Get("crn"))
+	}
+
+	// Import
+	imported := fakeResourceData(t, vpc.ResourceIBMISVPC(), time.Minute, nil)
+	imported.SetId(d.Id())
+	if err := r.Read(imported, meta); err != nil {
+		t.Fatalf("Error importing the VPC: %s", err)
+	}
+	if imported.Get("name") != "tf-fake-vpc" {
+		t.Errorf("Expected the imported name to be tf-fake-vpc, got %v", imported.Get("name"))
+	}
+
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("Error deleting the VPC: %s", err)
+	}
+	if server.Get(fakevpc.VPCs, d.Id()) != nil {
+		t.Error("Expected the VPC to be deleted")
+	}
+
+	// A resource deleted out of band is removed from the state
+	if err := r.Read(imported, meta); err != nil {
+		t.Fatalf("Error reading the deleted VPC: %s", err)
+	}
+	if imported.Id() != "" {
+		t.Errorf("Expected the deleted VPC to be removed from the state, got ID %q", imported.Id())
+	}
+}
+
+func TestIBMISVPC_fakeAPIFailures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("conflict", func(t *testing.T) {
+		t.Parallel()
+		server := fakevpc.NewServer()
+		defer server.Close()
+		server.Fail(http.MethodPost, "/vpcs", http.StatusConflict, 1)
+
+		r := vpc.ResourceIBMISVPC()
+		d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"name": "tf-fake-vpc"})
+		err := r.Create(d, server.ClientSession())
+		if err == nil || !flex.HasAPIErrorCode(err, "conflict") {
+			t.Fatalf("Expected a conflict error, got %v", err)
+		}
+		if d.Id() != "" {
+			t.Errorf("Expected no ID after a failed create, got %q", d.Id())
+		}
+	})
+
+	t.Run("server error while waiting", func(t *testing.T) {
+		t.Parallel()
+		server := fakevpc.NewServer()
+		defer server.Close()
+		server.SetPendingReads(fakevpc.VPCs, 1)
+		server.Fail(http.MethodGet, "/vpcs/r006-fake-vpc-0001", http.StatusInternalServerError, -1)
+
+		r := vpc.ResourceIBMISVPC()
+		d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"name": "tf-fake-vpc"})
+		if err := r.Create(d, server.ClientSession()); err == nil {
+			t.Fatal("Expected the waiter to fail")
+		}
+	})
+
+	t.Run("stuck pending", func(t *testing.T) {
+		t.Parallel()
+		server := fakevpc.NewServer()
+		defer server.Close()
+		server.SetPendingReads(fakevpc.VPCs, -1)
+
+		r := vpc.ResourceIBMISVPC()
+		d := fakeResourceData(t, r, 15*time.Second, map[string]interface{}{"name": "tf-fake-vpc"})
+		err := r.Create(d, server.ClientSession())
+		if _, ok := err.(*resource.TimeoutError); !ok {
+			t.Fatalf("Expected a timeout waiting for the VPC, got %v", err)
+		}
+	})
+
+	t.Run("delete in use", func(t *testing.T) {
+		t.Parallel()
+		server := fakevpc.NewServer()
+		defer server.Close()
+		meta := server.ClientSession()
+
+		r := vpc.ResourceIBMISVPC()
+		d := fakeResourceData(t, r, time.Minute, map[string]interface{}{"name": "tf-fake-vpc"})
+		if err := r.Create(d, meta); err != nil {
+			t.Fatalf("Error creating the VPC: %s", err)
+		}
+		sess, err := meta.VpcV1API()
+		if err != nil {
+			t.Fatal(err)
+		}
+		vpcID := d.Id()
+		zone := "us-south-1"
+		_, _, err = sess.CreateSubnet(&vpcv1.CreateSubnetOptions{
+			SubnetPrototype: &vpcv1.SubnetPrototype{
+				VPC:                   &vpcv1.VPCIdentity{ID: &vpcID},
+				Zone:                  &vpcv1.ZoneIdentity{Name: &zone},
+				TotalIpv4AddressCount: core.Int64Ptr(256),
+			},
+		})
+		if err != nil {
+			t.Fatalf("Error creating the subnet: %s", err)
+		}
+		if err := r.Delete(d, meta); err == nil {
+			t.Fatal("Expected the VPC with a subnet not to be deleted")
+		}
+	})
+}
@@ -0,0 +1,720 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isVPNServerDeleting  = "deleting"
+	isVPNServerDeleted   = "done"
+	isVPNServerFailed    = "failed"
+	isVPNServerPending   = "pending"
+	isVPNServerStable    = "stable"
+	isVPNServerSuspended = "suspended"
+	isVPNServerUpdating  = "updating"
+	isVPNServerWaiting   = "waiting"
+
+	isVPNServerAuthMethodCertificate = "certificate"
+	isVPNServerAuthMethodUsername    = "username"
+)
+
+func ResourceIBMIsVPNServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVPNServerCreate,
+		ReadContext:   resourceIBMIsVPNServerRead,
+		UpdateContext: resourceIBMIsVPNServerUpdate,
+		DeleteContext: resourceIBMIsVPNServerDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the certificate instance of this VPN server, in Secrets Manager or Certificate Manager.",
+			},
+			"client_authentication": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Description: "The methods used to authenticate VPN clients to this VPN server. VPN clients must authenticate with all the methods.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "method"),
+							Description:  "The type of authentication, certificate or username.",
+						},
+						"client_ca_crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the certificate instance to use as the CA of the VPN client certificates, certificate authentication only.",
+						},
+						"crl": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The certificate revocation list of the VPN client certificates in PEM format, certificate authentication only.",
+						},
+						"identity_provider": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "identity_provider"),
+							Description:  "The identity provider of the VPN client users, username authentication only. `iam` is the only supported value.",
+						},
+					},
+				},
+			},
+			"client_ip_pool": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN client IPv4 address pool, expressed in CIDR format. It must not overlap with the address prefixes of the VPC or of the networks reachable through this VPN server.",
+			},
+			"client_dns_server_ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The DNS server addresses that will be provided to VPN clients connected to this VPN server.",
+			},
+			"client_idle_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "client_idle_timeout"),
+				Description:  "The seconds a VPN client can be idle before this VPN server will disconnect it, 0 to disable.",
+			},
+			"enable_split_tunneling": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the split tunneling is enabled on this VPN server.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "name"),
+				Description:  "The user-defined name for this VPN server. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "port"),
+				Description:  "The port number to use for this VPN server.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server", "protocol"),
+				Description:  "The transport protocol to use for this VPN server, tcp or udp.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The unique identifiers of the security groups to use for this VPN server. If unspecified, the VPC's default security group is used.",
+			},
+			"subnets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The unique identifiers of the subnets to provision this VPN server in. Use two subnets in different zones for high availability.",
+			},
+			"client_auto_delete": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether disconnected VPN clients will be automatically deleted after client_auto_delete_timeout hours have passed.",
+			},
+			"client_auto_delete_timeout": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Hours after which disconnected VPN clients will be automatically deleted.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the VPN server was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this VPN server.",
+			},
+			"health_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of this resource: ok, degraded, faulted or inapplicable.",
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fully qualified domain name assigned to this VPN server.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this VPN server.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the VPN server.",
+			},
+			"private_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reserved IPs bound to this VPN server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined or system-provided name for this reserved IP.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of resource referenced.",
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the VPC this VPN server resides in.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsVPNServerValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "method",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "certificate, username",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "identity_provider",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "iam",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "client_idle_timeout",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "28800",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "port",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "protocol",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "tcp, udp",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_server", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientAuthentication, err := resourceIBMIsVPNServerClientAuthentication(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createVPNServerOptions := &vpcv1.CreateVPNServerOptions{
+		Certificate: &vpcv1.CertificateInstanceIdentity{
+			CRN: flex.PtrToString(d.Get("certificate_crn").(string)),
+		},
+		ClientAuthentication: clientAuthentication,
+		ClientIPPool:         flex.PtrToString(d.Get("client_ip_pool").(string)),
+		Subnets:              resourceIBMIsVPNServerSubnets(d),
+	}
+	if v, ok := d.GetOk("client_dns_server_ips"); ok {
+		createVPNServerOptions.ClientDnsServerIps = resourceIBMIsVPNServerIPs(v.(*schema.Set))
+	}
+	if v, ok := d.GetOkExists("client_idle_timeout"); ok {
+		createVPNServerOptions.SetClientIdleTimeout(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("enable_split_tunneling"); ok {
+		createVPNServerOptions.SetEnableSplitTunneling(v.(bool))
+	}
+	if v, ok := d.GetOk("name"); ok {
+		createVPNServerOptions.SetName(v.(string))
+	}
+	if v, ok := d.GetOk("port"); ok {
+		createVPNServerOptions.SetPort(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("protocol"); ok {
+		createVPNServerOptions.SetProtocol(v.(string))
+	}
+	if v, ok := d.GetOk("resource_group"); ok {
+		createVPNServerOptions.SetResourceGroup(&vpcv1.ResourceGroupIdentity{
+			ID: flex.PtrToString(v.(string)),
+		})
+	}
+	if v, ok := d.GetOk("security_groups"); ok {
+		securityGroups := []vpcv1.SecurityGroupIdentityIntf{}
+		for _, sg := range v.(*schema.Set).List() {
+			securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentity{
+				ID: flex.PtrToString(sg.(string)),
+			})
+		}
+		createVPNServerOptions.SetSecurityGroups(securityGroups)
+	}
+
+	vpnServer, response, err := vpcClient.CreateVPNServerWithContext(context, createVPNServerOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVPNServerWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "CreateVPNServer", err, response)
+	}
+
+	d.SetId(*vpnServer.ID)
+
+	_, err = isWaitForVPNServerStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for VPN server (%s) to be available: %s", d.Id(), err))
+	}
+
+	return resourceIBMIsVPNServerRead(context, d, meta)
+}
+
+// resourceIBMIsVPNServerClientAuthentication returns the client
+// authentication prototypes of the configuration.
+func resourceIBMIsVPNServerClientAuthentication(d *schema.ResourceData) ([]vpcv1.VPNServerAuthenticationPrototypeIntf, error) {
+	clientAuthentication := []vpcv1.VPNServerAuthenticationPrototypeIntf{}
+	for i, v := range d.Get("client_authentication").([]interface{}) {
+		auth := v.(map[string]interface{})
+		method := auth["method"].(string)
+		clientCaCRN := auth["client_ca_crn"].(string)
+		crl := auth["crl"].(string)
+		identityProvider := auth["identity_provider"].(string)
+		switch method {
+		case isVPNServerAuthMethodCertificate:
+			if clientCaCRN == "" || identityProvider != "" {
+				return nil, fmt.Errorf("[ERROR] client_authentication.%d: the certificate authentication requires client_ca_crn and doesn't support identity_provider", i)
+			}
+			prototype := &vpcv1.VPNServerAuthenticationPrototypeVPNServerAuthenticationByCertificatePrototype{
+				Method: &method,
+				ClientCa: &vpcv1.CertificateInstanceIdentity{
+					CRN: &clientCaCRN,
+				},
+			}
+			if crl != "" {
+				prototype.Crl = &crl
+			}
+			clientAuthentication = append(clientAuthentication, prototype)
+		case isVPNServerAuthMethodUsername:
+			if identityProvider == "" || clientCaCRN != "" || crl != "" {
+				return nil, fmt.Errorf("[ERROR] client_authentication.%d: the username authentication requires identity_provider and doesn't support client_ca_crn or crl", i)
+			}
+			clientAuthentication = append(clientAuthentication, &vpcv1.VPNServerAuthenticationPrototypeVPNServerAuthenticationByUsernamePrototype{
+				Method: &method,
+				IdentityProvider: &vpcv1.VPNServerAuthenticationByUsernameIDProviderByIam{
+					ProviderType: &identityProvider,
+				},
+			})
+		}
+	}
+	return clientAuthentication, nil
+}
+
+func resourceIBMIsVPNServerSubnets(d *schema.ResourceData) []vpcv1.SubnetIdentityIntf {
+	subnets := []vpcv1.SubnetIdentityIntf{}
+	for _, v := range d.Get("subnets").(*schema.Set).List() {
+		subnets = append(subnets, &vpcv1.SubnetIdentity{
+			ID: flex.PtrToString(v.(string)),
+		})
+	}
+	return subnets
+}
+
+func resourceIBMIsVPNServerIPs(addresses *schema.Set) []vpcv1.IP {
+	ips := []vpcv1.IP{}
+	for _, v := range addresses.List() {
+		ips = append(ips, vpcv1.IP{
+			Address: flex.PtrToString(v.(string)),
+		})
+	}
+	return ips
+}
+
+func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getVPNServerOptions := &vpcv1.GetVPNServerOptions{}
+	getVPNServerOptions.SetID(d.Id())
+
+	vpnServer, response, err := vpcClient.GetVPNServerWithContext(context, getVPNServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "GetVPNServer", err, response)
+	}
+
+	if err = d.Set("certificate_crn", vpnServer.Certificate.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting certificate_crn: %s", err))
+	}
+	clientAuthentication := []map[string]interface{}{}
+	for _, v := range vpnServer.ClientAuthentication {
+		auth, ok := v.(*vpcv1.VPNServerAuthentication)
+		if !ok {
+			continue
+		}
+		authMap := map[string]interface{}{
+			"method": auth.Method,
+		}
+		if auth.ClientCa != nil {
+			authMap["client_ca_crn"] = auth.ClientCa.CRN
+		}
+		if auth.Crl != nil {
+			authMap["crl"] = auth.Crl
+		}
+		if idp, ok := auth.IdentityProvider.(*vpcv1.VPNServerAuthenticationByUsernameIDProvider); ok && idp != nil {
+			authMap["identity_provider"] = idp.ProviderType
+		}
+		clientAuthentication = append(clientAuthentication, authMap)
+	}
+	if err = d.Set("client_authentication", clientAuthentication); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_authentication: %s", err))
+	}
+	if err = d.Set("client_ip_pool", vpnServer.ClientIPPool); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_ip_pool: %s", err))
+	}
+	clientDNSServerIPs := []string{}
+	for _, ip := range vpnServer.ClientDnsServerIps {
+		clientDNSServerIPs = append(clientDNSServerIPs, *ip.Address)
+	}
+	if err = d.Set("client_dns_server_ips", clientDNSServerIPs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_dns_server_ips: %s", err))
+	}
+	if err = d.Set("client_idle_timeout", flex.IntValue(vpnServer.ClientIdleTimeout)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_idle_timeout: %s", err))
+	}
+	if err = d.Set("enable_split_tunneling", vpnServer.EnableSplitTunneling); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enable_split_tunneling: %s", err))
+	}
+	if err = d.Set("name", vpnServer.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("port", flex.IntValue(vpnServer.Port)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting port: %s", err))
+	}
+	if err = d.Set("protocol", vpnServer.Protocol); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting protocol: %s", err))
+	}
+	if vpnServer.ResourceGroup != nil {
+		if err = d.Set("resource_group", vpnServer.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+		}
+	}
+	securityGroups := []string{}
+	for _, sg := range vpnServer.SecurityGroups {
+		securityGroups = append(securityGroups, *sg.ID)
+	}
+	if err = d.Set("security_groups", securityGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting security_groups: %s", err))
+	}
+	subnets := []string{}
+	for _, subnet := range vpnServer.Subnets {
+		subnets = append(subnets, *subnet.ID)
+	}
+	if err = d.Set("subnets", subnets); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subnets: %s", err))
+	}
+	if err = d.Set("client_auto_delete", vpnServer.ClientAutoDelete); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_auto_delete: %s", err))
+	}
+	if err = d.Set("client_auto_delete_timeout", flex.IntValue(vpnServer.ClientAutoDeleteTimeout)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_auto_delete_timeout: %s", err))
+	}
+	if err = d.Set("created_at", vpnServer.CreatedAt.String()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", vpnServer.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("health_state", vpnServer.HealthState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting health_state: %s", err))
+	}
+	if err = d.Set("hostname", vpnServer.Hostname); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting hostname: %s", err))
+	}
+	if err = d.Set("href", vpnServer.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", vpnServer.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	privateIps := []map[string]interface{}{}
+	for _, ip := range vpnServer.PrivateIps {
+		privateIps = append(privateIps, map[string]interface{}{
+			"address": ip.Address,
+			"id":      ip.ID,
+			"name":    ip.Name,
+		})
+	}
+	if err = d.Set("private_ips", privateIps); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_ips: %s", err))
+	}
+	if err = d.Set("resource_type", vpnServer.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if vpnServer.VPC != nil {
+		if err = d.Set("vpc", vpnServer.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpc: %s", err))
+		}
+	}
+	return nil
+}
+
+func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hasChange := false
+	vpnServerPatchModel := &vpcv1.VPNServerPatch{}
+	if d.HasChange("certificate_crn") {
+		vpnServerPatchModel.Certificate = &vpcv1.CertificateInstanceIdentity{
+			CRN: flex.PtrToString(d.Get("certificate_crn").(string)),
+		}
+		hasChange = true
+	}
+	if d.HasChange("client_authentication") {
+		clientAuthentication, err := resourceIBMIsVPNServerClientAuthentication(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		vpnServerPatchModel.ClientAuthentication = clientAuthentication
+		hasChange = true
+	}
+	removeClientDNSServerIPs := false
+	if d.HasChange("client_dns_server_ips") {
+		vpnServerPatchModel.ClientDnsServerIps = resourceIBMIsVPNServerIPs(d.Get("client_dns_server_ips").(*schema.Set))
+		removeClientDNSServerIPs = len(vpnServerPatchModel.ClientDnsServerIps) == 0
+		hasChange = true
+	}
+	if d.HasChange("client_idle_timeout") {
+		vpnServerPatchModel.ClientIdleTimeout = core.Int64Ptr(int64(d.Get("client_idle_timeout").(int)))
+		hasChange = true
+	}
+	if d.HasChange("client_ip_pool") {
+		vpnServerPatchModel.ClientIPPool = flex.PtrToString(d.Get("client_ip_pool").(string))
+		hasChange = true
+	}
+	if d.HasChange("enable_split_tunneling") {
+		vpnServerPatchModel.EnableSplitTunneling = core.BoolPtr(d.Get("enable_split_tunneling").(bool))
+		hasChange = true
+	}
+	if d.HasChange("name") {
+		vpnServerPatchModel.Name = flex.PtrToString(d.Get("name").(string))
+		hasChange = true
+	}
+	if d.HasChange("port") {
+		vpnServerPatchModel.Port = core.Int64Ptr(int64(d.Get("port").(int)))
+		hasChange = true
+	}
+	if d.HasChange("protocol") {
+		vpnServerPatchModel.Protocol = flex.PtrToString(d.Get("protocol").(string))
+		hasChange = true
+	}
+	if d.HasChange("subnets") {
+		vpnServerPatchModel.Subnets = resourceIBMIsVPNServerSubnets(d)
+		hasChange = true
+	}
+
+	if hasChange {
+		vpnServerPatch, err := vpnServerPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for VPNServerPatch: %s", err))
+		}
+		// An empty list is dropped from the patch, it is the way to remove
+		// all the DNS servers
+		if removeClientDNSServerIPs {
+			vpnServerPatch["client_dns_server_ips"] = []interface{}{}
+		}
+		updateVPNServerOptions := &vpcv1.UpdateVPNServerOptions{}
+		updateVPNServerOptions.SetID(d.Id())
+		updateVPNServerOptions.SetVPNServerPatch(vpnServerPatch)
+		_, response, err := vpcClient.UpdateVPNServerWithContext(context, updateVPNServerOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVPNServerWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "UpdateVPNServer", err, response)
+		}
+		_, err = isWaitForVPNServerStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for VPN server (%s) to be updated: %s", d.Id(), err))
+		}
+	}
+
+	return resourceIBMIsVPNServerRead(context, d, meta)
+}
+
+func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteVPNServerOptions := &vpcv1.DeleteVPNServerOptions{}
+	deleteVPNServerOptions.SetID(d.Id())
+
+	response, err := vpcClient.DeleteVPNServerWithContext(context, deleteVPNServerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVPNServerWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "DeleteVPNServer", err, response)
+	}
+
+	_, err = isWaitForVPNServerDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for VPN server (%s) to be deleted: %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForVPNServerStable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerPending, isVPNServerUpdating, isVPNServerWaiting},
+		Target:  []string{isVPNServerStable},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerOptions := &vpcv1.GetVPNServerOptions{}
+			getVPNServerOptions.SetID(id)
+			vpnServer, response, err := vpcClient.GetVPNServerWithContext(context, getVPNServerOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server: %s\n%s", err, response)
+			}
+			if *vpnServer.LifecycleState == isVPNServerFailed || *vpnServer.LifecycleState == isVPNServerSuspended {
+				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("[ERROR] The VPN server %s is %s", id, *vpnServer.LifecycleState)
+			}
+			return vpnServer, *vpnServer.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForVPNServerDeleted(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerDeleting, isVPNServerStable, isVPNServerUpdating},
+		Target:  []string{isVPNServerDeleted},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerOptions := &vpcv1.GetVPNServerOptions{}
+			getVPNServerOptions.SetID(id)
+			vpnServer, response, err := vpcClient.GetVPNServerWithContext(context, getVPNServerOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return vpnServer, isVPNServerDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server: %s\n%s", err, response)
+			}
+			if *vpnServer.LifecycleState == isVPNServerFailed {
+				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("[ERROR] The VPN server %s failed to delete", id)
+			}
+			return vpnServer, *vpnServer.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
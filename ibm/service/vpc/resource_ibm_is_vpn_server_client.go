@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// ResourceIBMIsVPNServerClient disconnects, or deletes, a VPN client of a VPN server.
+// The VPN clients are created by the VPN server when they connect, so destroying
+// this resource only removes it from the state.
+func ResourceIBMIsVPNServerClient() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVPNServerClientCreate,
+		ReadContext:   resourceIBMIsVPNServerClientRead,
+		DeleteContext: resourceIBMIsVPNServerClientDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the VPN server.",
+			},
+			"vpn_client": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the VPN client to disconnect.",
+			},
+			"delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If true, the VPN client is deleted instead of disconnected. Deleting a VPN client also disconnects it.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the VPN client: connected or disconnected.",
+			},
+		},
+	}
+}
+
+func resourceIBMIsVPNServerClientCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get("vpn_server").(string)
+	vpnClientID := d.Get("vpn_client").(string)
+
+	if d.Get("delete").(bool) {
+		deleteVPNServerClientOptions := &vpcv1.DeleteVPNServerClientOptions{}
+		deleteVPNServerClientOptions.SetVPNServerID(vpnServerID)
+		deleteVPNServerClientOptions.SetID(vpnClientID)
+		response, err := vpcClient.DeleteVPNServerClientWithContext(context, deleteVPNServerClientOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteVPNServerClientWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "DeleteVPNServerClient", err, response)
+		}
+	} else {
+		disconnectVPNClientOptions := &vpcv1.DisconnectVPNClientOptions{}
+		disconnectVPNClientOptions.SetVPNServerID(vpnServerID)
+		disconnectVPNClientOptions.SetID(vpnClientID)
+		response, err := vpcClient.DisconnectVPNClientWithContext(context, disconnectVPNClientOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DisconnectVPNClientWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "DisconnectVPNClient", err, response)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", vpnServerID, vpnClientID))
+	return resourceIBMIsVPNServerClientRead(context, d, meta)
+}
+
+func resourceIBMIsVPNServerClientRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid VPN server client ID %s, expected vpn_server/vpn_client", d.Id()))
+	}
+
+	getVPNServerClientOptions := &vpcv1.GetVPNServerClientOptions{}
+	getVPNServerClientOptions.SetVPNServerID(parts[0])
+	getVPNServerClientOptions.SetID(parts[1])
+	vpnServerClient, response, err := vpcClient.GetVPNServerClientWithContext(context, getVPNServerClientOptions)
+	if err != nil {
+		// A disconnected client is deleted by the VPN server after the
+		// client_auto_delete_timeout, the action itself stays done.
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "GetVPNServerClient", err, response)
+	}
+
+	if err = d.Set("vpn_server", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpn_server: %s", err))
+	}
+	if err = d.Set("vpn_client", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpn_client: %s", err))
+	}
+	if err = d.Set("status", vpnServerClient.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func ResourceIBMIsVPNServerRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVPNServerRouteCreate,
+		ReadContext:   resourceIBMIsVPNServerRouteRead,
+		UpdateContext: resourceIBMIsVPNServerRouteUpdate,
+		DeleteContext: resourceIBMIsVPNServerRouteDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the VPN server.",
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The destination of this VPN server route, in CIDR format. VPN clients route the traffic to this destination through the VPN server.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "deliver",
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server_route", "action"),
+				Description:  "The action to perform with a packet matching the route: deliver, drop or translate.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_server_route", "name"),
+				Description:  "The user-defined name for this VPN server route. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			"vpn_route": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this VPN server route.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the VPN server route was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this VPN server route.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the VPN server route.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsVPNServerRouteValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "action",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "deliver, drop, translate",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_server_route", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsVPNServerRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get("vpn_server").(string)
+	createVPNServerRouteOptions := &vpcv1.CreateVPNServerRouteOptions{}
+	createVPNServerRouteOptions.SetVPNServerID(vpnServerID)
+	createVPNServerRouteOptions.SetDestination(d.Get("destination").(string))
+	createVPNServerRouteOptions.SetAction(d.Get("action").(string))
+	if v, ok := d.GetOk("name"); ok {
+		createVPNServerRouteOptions.SetName(v.(string))
+	}
+
+	vpnServerRoute, response, err := vpcClient.CreateVPNServerRouteWithContext(context, createVPNServerRouteOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVPNServerRouteWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "CreateVPNServerRoute", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", vpnServerID, *vpnServerRoute.ID))
+
+	_, err = isWaitForVPNServerRouteStable(context, vpcClient, vpnServerID, *vpnServerRoute.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for VPN server route (%s) to be available: %s", d.Id(), err))
+	}
+
+	return resourceIBMIsVPNServerRouteRead(context, d, meta)
+}
+
+func resourceIBMIsVPNServerRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid VPN server route ID %s, expected vpn_server/vpn_route", d.Id()))
+	}
+
+	getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{}
+	getVPNServerRouteOptions.SetVPNServerID(parts[0])
+	getVPNServerRouteOptions.SetID(parts[1])
+
+	vpnServerRoute, response, err := vpcClient.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "GetVPNServerRoute", err, response)
+	}
+
+	if err = d.Set("vpn_server", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpn_server: %s", err))
+	}
+	if err = d.Set("vpn_route", vpnServerRoute.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpn_route: %s", err))
+	}
+	if err = d.Set("destination", vpnServerRoute.Destination); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting destination: %s", err))
+	}
+	if err = d.Set("action", vpnServerRoute.Action); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting action: %s", err))
+	}
+	if err = d.Set("name", vpnServerRoute.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("created_at", vpnServerRoute.CreatedAt.String()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("href", vpnServerRoute.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", vpnServerRoute.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", vpnServerRoute.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMIsVPNServerRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil || len(parts) != 2 {
+			return diag.FromErr(fmt.Errorf("[ERROR] Invalid VPN server route ID %s, expected vpn_server/vpn_route", d.Id()))
+		}
+		vpnServerRoutePatchModel := &vpcv1.VPNServerRoutePatch{
+			Name: flex.PtrToString(d.Get("name").(string)),
+		}
+		vpnServerRoutePatch, err := vpnServerRoutePatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for VPNServerRoutePatch: %s", err))
+		}
+		updateVPNServerRouteOptions := &vpcv1.UpdateVPNServerRouteOptions{}
+		updateVPNServerRouteOptions.SetVPNServerID(parts[0])
+		updateVPNServerRouteOptions.SetID(parts[1])
+		updateVPNServerRouteOptions.SetVPNServerRoutePatch(vpnServerRoutePatch)
+		_, response, err := vpcClient.UpdateVPNServerRouteWithContext(context, updateVPNServerRouteOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVPNServerRouteWithContext failed %s\n%s", err, response)
+			return flex.APIErrorDiagnostics("vpc", "UpdateVPNServerRoute", err, response)
+		}
+	}
+
+	return resourceIBMIsVPNServerRouteRead(context, d, meta)
+}
+
+func resourceIBMIsVPNServerRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Invalid VPN server route ID %s, expected vpn_server/vpn_route", d.Id()))
+	}
+
+	deleteVPNServerRouteOptions := &vpcv1.DeleteVPNServerRouteOptions{}
+	deleteVPNServerRouteOptions.SetVPNServerID(parts[0])
+	deleteVPNServerRouteOptions.SetID(parts[1])
+
+	response, err := vpcClient.DeleteVPNServerRouteWithContext(context, deleteVPNServerRouteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVPNServerRouteWithContext failed %s\n%s", err, response)
+		return flex.APIErrorDiagnostics("vpc", "DeleteVPNServerRoute", err, response)
+	}
+
+	_, err = isWaitForVPNServerRouteDeleted(context, vpcClient, parts[0], parts[1], d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for VPN server route (%s) to be deleted: %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForVPNServerRouteStable(context context.Context, vpcClient *vpcv1.VpcV1, vpnServerID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server route (%s/%s) to be stable.", vpnServerID, id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerPending, isVPNServerUpdating, isVPNServerWaiting},
+		Target:  []string{isVPNServerStable},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{}
+			getVPNServerRouteOptions.SetVPNServerID(vpnServerID)
+			getVPNServerRouteOptions.SetID(id)
+			vpnServerRoute, response, err := vpcClient.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server route: %s\n%s", err, response)
+			}
+			if *vpnServerRoute.LifecycleState == isVPNServerFailed || *vpnServerRoute.LifecycleState == isVPNServerSuspended {
+				return vpnServerRoute, *vpnServerRoute.LifecycleState, fmt.Errorf("[ERROR] The VPN server route %s is %s", id, *vpnServerRoute.LifecycleState)
+			}
+			return vpnServerRoute, *vpnServerRoute.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForVPNServerRouteDeleted(context context.Context, vpcClient *vpcv1.VpcV1, vpnServerID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server route (%s/%s) to be deleted.", vpnServerID, id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerDeleting, isVPNServerStable, isVPNServerUpdating},
+		Target:  []string{isVPNServerDeleted},
+		Refresh: func() (interface{}, string, error) {
+			getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{}
+			getVPNServerRouteOptions.SetVPNServerID(vpnServerID)
+			getVPNServerRouteOptions.SetID(id)
+			vpnServerRoute, response, err := vpcClient.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return vpnServerRoute, isVPNServerDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting VPN server route: %s\n%s", err, response)
+			}
+			if *vpnServerRoute.LifecycleState == isVPNServerFailed {
+				return vpnServerRoute, *vpnServerRoute.LifecycleState, fmt.Errorf("[ERROR] The VPN server route %s failed to delete", id)
+			}
+			return vpnServerRoute, *vpnServerRoute.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsVPNServerRouteBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpnroute-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpnroute-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnServerName := fmt.Sprintf("tfvpnroute-server-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfvpnroute-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tfvpnroute-update-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVPNServerRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNServerRouteConfigBasic(vpcname, subnetname, vpnServerName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.is_vpn_server_route", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.is_vpn_server_route", "destination", "172.16.0.0/16"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.is_vpn_server_route", "action", "deliver"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.is_vpn_server_route", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpn_server_route.is_vpn_server_route", "vpn_route"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVPNServerRouteConfigBasic(vpcname, subnetname, vpnServerName, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.is_vpn_server_route", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpn_server_route.is_vpn_server_route",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNServerRouteConfigBasic(vpcname, subnetname, vpnServerName, name string) string {
+	return testAccCheckIBMIsVPNServerConfigBasic(vpcname, subnetname, vpnServerName, 600) + fmt.Sprintf(`
+	resource "ibm_is_vpn_server_route" "is_vpn_server_route" {
+		vpn_server  = ibm_is_vpn_server.is_vpn_server.id
+		destination = "172.16.0.0/16"
+		name        = "%s"
+	}
+	`, name)
+}
+
+func testAccCheckIBMIsVPNServerRouteDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_server_route" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{}
+		getVPNServerRouteOptions.SetVPNServerID(parts[0])
+		getVPNServerRouteOptions.SetID(parts[1])
+
+		_, response, err := vpcClient.GetVPNServerRoute(getVPNServerRouteOptions)
+		if err == nil {
+			return fmt.Errorf("VPNServerRoute still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for VPNServerRoute (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
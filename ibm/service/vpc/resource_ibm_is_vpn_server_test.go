@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsVPNServerBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpnserver-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpnserver-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfvpnserver-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tfvpnserver-update-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVPNServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNServerConfigBasic(vpcname, subnetname, name, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVPNServerExists("ibm_is_vpn_server.is_vpn_server"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "client_ip_pool", "10.5.0.0/21"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "client_idle_timeout", "600"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "client_authentication.0.method", "username"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpn_server.is_vpn_server", "hostname"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVPNServerConfigBasic(vpcname, subnetname, nameUpdate, 1200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "client_idle_timeout", "1200"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpn_server.is_vpn_server",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNServerConfigBasic(vpcname, subnetname, name string, idleTimeout int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "is_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "is_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.is_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_vpn_server" "is_vpn_server" {
+		certificate_crn = "%s"
+		client_authentication {
+			method            = "username"
+			identity_provider = "iam"
+		}
+		client_ip_pool         = "10.5.0.0/21"
+		client_dns_server_ips  = ["192.168.3.4"]
+		client_idle_timeout    = %d
+		enable_split_tunneling = false
+		name                   = "%s"
+		port                   = 443
+		protocol               = "udp"
+		subnets                = [ibm_is_subnet.is_subnet.id]
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCertificateCrn, idleTimeout, name)
+}
+
+func testAccCheckIBMIsVPNServerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+
+		getVPNServerOptions := &vpcv1.GetVPNServerOptions{}
+		getVPNServerOptions.SetID(rs.Primary.ID)
+
+		_, _, err = vpcClient.GetVPNServer(getVPNServerOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMIsVPNServerDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_server" {
+			continue
+		}
+
+		getVPNServerOptions := &vpcv1.GetVPNServerOptions{}
+		getVPNServerOptions.SetID(rs.Primary.ID)
+
+		_, response, err := vpcClient.GetVPNServer(getVPNServerOptions)
+		if err == nil {
+			return fmt.Errorf("VPNServer still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for VPNServer (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ValidateJSONSchema checks that the value is a JSON document conforming to
+// jsonSchema. The keywords supported are type, enum, const, properties,
+// required, additionalProperties, items, minItems, maxItems, minimum, maximum,
+// minLength, maxLength and pattern, which covers the schemas of the service
+// parameters the provider validates. Unknown keywords are ignored.
+func ValidateJSONSchema(jsonSchema string) schema.SchemaValidateFunc {
+	var root map[string]interface{}
+	schemaErr := json.Unmarshal([]byte(jsonSchema), &root)
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if schemaErr != nil {
+			errors = append(errors, fmt.Errorf("%q can't be validated, the JSON schema is invalid: %s", k, schemaErr))
+			return
+		}
+		var doc interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &doc); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+			return
+		}
+		for _, err := range validateJSONValue(root, doc, "$") {
+			errors = append(errors, fmt.Errorf("%q does not match its JSON schema: %s", k, err))
+		}
+		return
+	}
+}
+
+func validateJSONValue(s map[string]interface{}, v interface{}, path string) []error {
+	var errs []error
+	if t, ok := s["type"]; ok && !matchesJSONType(t, v) {
+		return append(errs, fmt.Errorf("%s must be of type %v, got %s", path, t, jsonTypeOf(v)))
+	}
+	if enum, ok := s["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			if reflect.DeepEqual(e, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Errorf("%s must be one of %v", path, enum))
+		}
+	}
+	if c, ok := s["const"]; ok && !reflect.DeepEqual(c, v) {
+		errs = append(errs, fmt.Errorf("%s must be %v", path, c))
+	}
+
+	switch value := v.(type) {
+	case map[string]interface{}:
+		properties, _ := s["properties"].(map[string]interface{})
+		if required, ok := s["required"].([]interface{}); ok {
+			for _, r := range required {
+				if _, ok := value[fmt.Sprint(r)]; !ok {
+					errs = append(errs, fmt.Errorf("%s.%v is required", path, r))
+				}
+			}
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if p, ok := properties[key].(map[string]interface{}); ok {
+				errs = append(errs, validateJSONValue(p, value[key], path+"."+key)...)
+				continue
+			}
+			switch additional := s["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					errs = append(errs, fmt.Errorf("%s.%s is not allowed", path, key))
+				}
+			case map[string]interface{}:
+				errs = append(errs, validateJSONValue(additional, value[key], path+"."+key)...)
+			}
+		}
+	case []interface{}:
+		if min, ok := s["minItems"].(float64); ok && float64(len(value)) < min {
+			errs = append(errs, fmt.Errorf("%s must have at least %v items", path, min))
+		}
+		if max, ok := s["maxItems"].(float64); ok && float64(len(value)) > max {
+			errs = append(errs, fmt.Errorf("%s must have at most %v items", path, max))
+		}
+		if items, ok := s["items"].(map[string]interface{}); ok {
+			for i, item := range value {
+				errs = append(errs, validateJSONValue(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case float64:
+		if min, ok := s["minimum"].(float64); ok && value < min {
+			errs = append(errs, fmt.Errorf("%s must be at least %v", path, min))
+		}
+		if max, ok := s["maximum"].(float64); ok && value > max {
+			errs = append(errs, fmt.Errorf("%s must be at most %v", path, max))
+		}
+	case string:
+		length := float64(utf8.RuneCountInString(value))
+		if min, ok := s["minLength"].(float64); ok && length < min {
+			errs = append(errs, fmt.Errorf("%s must be at least %v characters long", path, min))
+		}
+		if max, ok := s["maxLength"].(float64); ok && length > max {
+			errs = append(errs, fmt.Errorf("%s must be at most %v characters long", path, max))
+		}
+		if pattern, ok := s["pattern"].(string); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s has an invalid pattern %q: %s", path, pattern, err))
+			} else if !re.MatchString(value) {
+				errs = append(errs, fmt.Errorf("%s must match %s", path, pattern))
+			}
+		}
+	}
+	return errs
+}
+
+// matchesJSONType reports whether v is of the JSON schema type t, either a
+// type name or a list of type names.
+func matchesJSONType(t interface{}, v interface{}) bool {
+	switch types := t.(type) {
+	case string:
+		actual := jsonTypeOf(v)
+		return actual == types || (types == "number" && actual == "integer")
+	case []interface{}:
+		for _, name := range types {
+			if matchesJSONType(name, v) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func jsonTypeOf(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if value == float64(int64(value)) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return strings.ToLower(reflect.TypeOf(v).Kind().String())
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// validatorReference is a lookup of InvokeValidator or
+// InvokeDataSourceValidator.
+type validatorReference struct {
+	dataSource bool
+	name       string
+	identifier string
+}
+
+var (
+	validatorReferencesMu sync.Mutex
+	// References looked up so far, and whether the dictionary had them
+	validatorReferences = map[validatorReference]bool{}
+)
+
+func recordValidatorReference(dataSource bool, name, identifier string, found bool) {
+	validatorReferencesMu.Lock()
+	defer validatorReferencesMu.Unlock()
+	validatorReferences[validatorReference{dataSource: dataSource, name: name, identifier: identifier}] = found
+}
+
+func (r validatorReference) String() string {
+	kind := "resource"
+	if r.dataSource {
+		kind = "data source"
+	}
+	return fmt.Sprintf("%s %s, identifier %s", kind, r.name, r.identifier)
+}
+
+// ValidatorDictProblems cross checks the validator dictionary against the
+// lookups made so far, typically while building the provider schema. The
+// problems are the validators that were referenced but are not registered,
+// which silently disables the validation of the argument, and the validators
+// registered under another name than their ResourceName, which are never
+// found. The registered validators that were never referenced are returned
+// apart.
+func ValidatorDictProblems() (problems []string, unused []string) {
+	validatorReferencesMu.Lock()
+	defer validatorReferencesMu.Unlock()
+
+	for ref, found := range validatorReferences {
+		if !found {
+			problems = append(problems, fmt.Sprintf("validator of %s is not registered, the argument is not validated", ref))
+		}
+	}
+	check := func(dataSource bool, dict map[string]*ResourceValidator) {
+		for name, validator := range dict {
+			if validator == nil {
+				continue
+			}
+			if validator.ResourceName != name {
+				problems = append(problems, fmt.Sprintf("validator registered as %s is named %s, the lookups of %s don't find it", name, validator.ResourceName, name))
+			}
+			for _, s := range validator.Schema {
+				if s.Identifier == "" {
+					continue
+				}
+				ref := validatorReference{dataSource: dataSource, name: name, identifier: s.Identifier}
+				if _, ok := validatorReferences[ref]; !ok {
+					unused = append(unused, fmt.Sprintf("validator of %s is never referenced", ref))
+				}
+			}
+		}
+	}
+	check(false, validatorDict.ResourceValidatorDictionary)
+	check(true, validatorDict.DataSourceValidatorDictionary)
+	sort.Strings(problems)
+	sort.Strings(unused)
+	return problems, unused
+}
+
+// MarshalJSON dumps the dictionary, function identifiers and types are
+// rendered by name.
+func (v ValidatorDict) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ResourceValidatorDictionary   map[string]*ResourceValidator `json:"resources"`
+		DataSourceValidatorDictionary map[string]*ResourceValidator `json:"data_sources"`
+	}{v.ResourceValidatorDictionary, v.DataSourceValidatorDictionary})
+}
@@ -1028,6 +1028,55 @@ func ValidateISName(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidateCRNValue checks that the value is a CRN of the given service and
+// resource type, crn:v1:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>.
+// Empty serviceName or resourceType accept any value.
+func ValidateCRNValue(serviceName, resourceType string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		parts := strings.Split(value, ":")
+		if len(parts) != 10 || parts[0] != "crn" || parts[1] != "v1" || parts[4] == "" {
+			errors = append(errors, fmt.Errorf(
+				"%q (%q) must be a CRN of the form crn:v1:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>", k, value))
+			return
+		}
+		if serviceName != "" && parts[4] != serviceName {
+			errors = append(errors, fmt.Errorf(
+				"%q (%q) must be the CRN of a %s resource, got service %q", k, value, serviceName, parts[4]))
+		}
+		if resourceType != "" && parts[8] != resourceType {
+			errors = append(errors, fmt.Errorf(
+				"%q (%q) must be the CRN of a %s, got resource type %q", k, value, resourceType, parts[8]))
+		}
+		return
+	}
+}
+
+// ValidateDurationBetween checks that the value is a duration such as 30s or
+// 1h30m within min and max. Empty bounds are not enforced.
+func ValidateDurationBetween(min, max string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf(
+				"%q (%q) must be a duration such as 30s or 1h30m: %s", k, value, err))
+			return
+		}
+		if min != "" {
+			if minDuration, err := time.ParseDuration(min); err == nil && d < minDuration {
+				errors = append(errors, fmt.Errorf("%q (%q) must be at least %s", k, value, min))
+			}
+		}
+		if max != "" {
+			if maxDuration, err := time.ParseDuration(max); err == nil && d > maxDuration {
+				errors = append(errors, fmt.Errorf("%q (%q) must be at most %s", k, value, max))
+			}
+		}
+		return
+	}
+}
+
 // ValidateFunc is honored only when the schema's Type is set to TypeInt,
 // TypeFloat, TypeString, TypeBool, or TypeMap. It is ignored for all other types.
 // enum to list all the validator functions supported by this tool.
@@ -1049,8 +1098,33 @@ const (
 	ValidateJSONParam
 	ValidateBindedPackageName
 	ValidateOverlappingAddress
+	ValidateCRN
+	ValidateDuration
+	ValidateJSONAgainstSchema
 )
 
+// Names of the FunctionIdentifier values, in the order of their declaration.
+var functionIdentifierNames = [...]string{
+	"IntBetween",
+	"IntAtLeast",
+	"IntAtMost",
+	"ValidateAllowedStringValue",
+	"StringLenBetween",
+	"ValidateIPorCIDR",
+	"ValidateCIDRAddress",
+	"ValidateAllowedIntValue",
+	"ValidateRegexpLen",
+	"ValidateRegexp",
+	"ValidateNoZeroValues",
+	"ValidateJSONString",
+	"ValidateJSONParam",
+	"ValidateBindedPackageName",
+	"ValidateOverlappingAddress",
+	"ValidateCRN",
+	"ValidateDuration",
+	"ValidateJSONAgainstSchema",
+}
+
 // MarshalText implements the encoding.TextMarshaler interface.
 //	Without this function, when FunctionalIdentifier is marshaled, it prints 0,1,2.. instead
 //	of printing IntBetween, IntAtLeast, IntAtMost.. in JSON Output
@@ -1060,7 +1134,10 @@ func (f FunctionIdentifier) MarshalText() ([]byte, error) {
 
 // Use stringer tool to generate this later.
 func (i FunctionIdentifier) String() string {
-	return [...]string{"IntBetween", "IntAtLeast", "IntAtMost", "ValidateAllowedStringValue", "StringLenBetween", "ValidateIPorCIDR", "ValidateAllowedIntValue", "ValidateRegexpLen", "ValidateRegexp", "ValidateNoZeroValues", "ValidateJSONString", "ValidateJSONParam", "ValidateBindedPackageName", "ValidateOverlappingAddress"}[i]
+	if i < 0 || int(i) >= len(functionIdentifierNames) {
+		return fmt.Sprintf("FunctionIdentifier(%d)", int(i))
+	}
+	return functionIdentifierNames[i]
 }
 
 // ValueType -- Copied from Terraform for now. You can refer to Terraform ValueType directly.
@@ -1136,6 +1213,14 @@ type ValidateSchema struct {
 	Required bool
 	Default  interface{}
 	ForceNew bool
+
+	// Service name and resource type expected by ValidateCRN, empty values
+	// match any service or resource type
+	CRNServiceName  string
+	CRNResourceType string
+
+	// JSON schema enforced by ValidateJSONAgainstSchema
+	JSONSchema string
 }
 
 type ResourceValidator struct {
@@ -1171,7 +1256,7 @@ func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc
 	var schemaToInvoke ValidateSchema
 	found := false
 	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
-	if resourceItem.ResourceName == resourceName {
+	if resourceItem != nil && resourceItem.ResourceName == resourceName {
 		parameterValidateSchema := resourceItem.Schema
 		for _, validateSchema := range parameterValidateSchema {
 			if validateSchema.Identifier == identifier {
@@ -1182,10 +1267,10 @@ func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc
 		}
 	}
 
+	recordValidatorReference(false, resourceName, identifier, found)
 	if found {
 		return invokeValidatorInternal(schemaToInvoke)
 	} else {
-		// Add error code later. TODO
 		return nil
 	}
 }
@@ -1196,7 +1281,7 @@ func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaVal
 	found := false
 
 	dataSourceItem := validatorDict.DataSourceValidatorDictionary[resourceName]
-	if dataSourceItem.ResourceName == resourceName {
+	if dataSourceItem != nil && dataSourceItem.ResourceName == resourceName {
 		parameterValidateSchema := dataSourceItem.Schema
 		for _, validateSchema := range parameterValidateSchema {
 			if validateSchema.Identifier == identifier {
@@ -1207,10 +1292,10 @@ func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaVal
 		}
 	}
 
+	recordValidatorReference(true, resourceName, identifier, found)
 	if found {
 		return invokeValidatorInternal(schemaToInvoke)
 	} else {
-		// Add error code later. TODO
 		return nil
 	}
 }
@@ -1255,6 +1340,12 @@ func invokeValidatorInternal(schema ValidateSchema) schema.SchemaValidateFunc {
 		return validateBindedPackageName()
 	case ValidateOverlappingAddress:
 		return validateOverlappingAddress()
+	case ValidateCRN:
+		return ValidateCRNValue(schema.CRNServiceName, schema.CRNResourceType)
+	case ValidateDuration:
+		return ValidateDurationBetween(schema.MinValue, schema.MaxValue)
+	case ValidateJSONAgainstSchema:
+		return ValidateJSONSchema(schema.JSONSchema)
 
 	default:
 		return nil
@@ -1333,11 +1424,11 @@ func (vs ValidateSchema) Zero() interface{} {
 	case TypeBool:
 		return false
 	case TypeInt:
-		return make([]string, 0)
+		return make([]int, 0)
 	case TypeFloat:
 		return 0.0
 	case TypeString:
-		return make([]int, 0)
+		return make([]string, 0)
 	default:
 		panic(fmt.Sprintf("unknown type %s", vs.Type))
 	}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestFunctionIdentifierString(t *testing.T) {
+	if len(functionIdentifierNames) != int(ValidateJSONAgainstSchema)+1 {
+		t.Fatalf("Expected %d names, got %d", int(ValidateJSONAgainstSchema)+1, len(functionIdentifierNames))
+	}
+	cases := map[FunctionIdentifier]string{
+		IntBetween:                 "IntBetween",
+		ValidateCIDRAddress:        "ValidateCIDRAddress",
+		ValidateAllowedIntValue:    "ValidateAllowedIntValue",
+		ValidateOverlappingAddress: "ValidateOverlappingAddress",
+		ValidateJSONAgainstSchema:  "ValidateJSONAgainstSchema",
+	}
+	for id, name := range cases {
+		if id.String() != name {
+			t.Errorf("Expected %s, got %s", name, id.String())
+		}
+	}
+}
+
+func TestValidateSchemaZero(t *testing.T) {
+	if _, ok := (ValidateSchema{Type: TypeInt}).Zero().([]int); !ok {
+		t.Error("Expected the zero value of TypeInt to be []int")
+	}
+	if _, ok := (ValidateSchema{Type: TypeString}).Zero().([]string); !ok {
+		t.Error("Expected the zero value of TypeString to be []string")
+	}
+}
+
+func TestValidateCRNValue(t *testing.T) {
+	vpcCRN := "crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-1234"
+	cases := []struct {
+		service, resourceType, value string
+		valid                        bool
+	}{
+		{"", "", vpcCRN, true},
+		{"is", "vpc", vpcCRN, true},
+		{"is", "subnet", vpcCRN, false},
+		{"kms", "", vpcCRN, false},
+		{"", "", "crn:v1:bluemix:public:is", false},
+		{"", "", "r006-1234", false},
+	}
+	for _, tc := range cases {
+		_, errs := ValidateCRNValue(tc.service, tc.resourceType)(tc.value, "crn")
+		if (len(errs) == 0) != tc.valid {
+			t.Errorf("%s/%s %q: expected valid=%t, got %v", tc.service, tc.resourceType, tc.value, tc.valid, errs)
+		}
+	}
+}
+
+func TestValidateDurationBetween(t *testing.T) {
+	f := ValidateDurationBetween("1m", "1h")
+	for value, valid := range map[string]bool{"30m": true, "1h": true, "30s": false, "2h": false, "soon": false} {
+		if _, errs := f(value, "timeout"); (len(errs) == 0) != valid {
+			t.Errorf("%q: expected valid=%t, got %v", value, valid, errs)
+		}
+	}
+}
+
+func TestValidateJSONSchema(t *testing.T) {
+	f := ValidateJSONSchema(`{
+		"type": "object",
+		"required": ["name"],
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "pattern": "^[a-z-]+$"},
+			"size": {"type": "integer", "minimum": 10, "maximum": 100},
+			"zones": {"type": "array", "items": {"enum": ["us-south-1", "us-south-2"]}}
+		}
+	}`)
+	cases := map[string]bool{
+		`{"name": "data", "size": 10, "zones": ["us-south-1"]}`: true,
+		`{"name": "data"}`:                                      true,
+		`{"size": 10}`:                                          false,
+		`{"name": "Data"}`:                                      false,
+		`{"name": "data", "size": 5}`:                           false,
+		`{"name": "data", "size": 10.5}`:                        false,
+		`{"name": "data", "zones": ["eu-de-1"]}`:                false,
+		`{"name": "data", "extra": true}`:                       false,
+		`["data"]`:                                              false,
+		`{"name": `:                                             false,
+	}
+	for value, valid := range cases {
+		if _, errs := f(value, "parameters"); (len(errs) == 0) != valid {
+			t.Errorf("%s: expected valid=%t, got %v", value, valid, errs)
+		}
+	}
+
+	if _, errs := ValidateJSONSchema(`{`)(`{}`, "parameters"); len(errs) == 0 {
+		t.Error("Expected an error for an invalid JSON schema")
+	}
+}
+
+func TestValidatorDictProblems(t *testing.T) {
+	defer SetValidatorDict(validatorDict)
+	SetValidatorDict(ValidatorDict{
+		ResourceValidatorDictionary: map[string]*ResourceValidator{
+			"ibm_test": {ResourceName: "ibm_test", Schema: []ValidateSchema{
+				{Identifier: "name", ValidateFunctionIdentifier: ValidateNoZeroValues, Type: TypeString},
+				{Identifier: "unused", ValidateFunctionIdentifier: ValidateNoZeroValues, Type: TypeString},
+			}},
+			"ibm_misnamed": {ResourceName: "ibm_other"},
+		},
+	})
+
+	if InvokeValidator("ibm_test", "name") == nil {
+		t.Error("Expected the validator of name")
+	}
+	if InvokeValidator("ibm_test", "nmae") != nil {
+		t.Error("Expected no validator for a misspelled identifier")
+	}
+	if InvokeValidator("ibm_unknown", "name") != nil {
+		t.Error("Expected no validator for an unknown resource")
+	}
+
+	missing, unused := ValidatorDictProblems()
+	for _, ref := range []string{"resource ibm_test, identifier nmae", "resource ibm_unknown, identifier name", "registered as ibm_misnamed is named ibm_other"} {
+		if !containsSubstring(missing, ref) {
+			t.Errorf("Expected %q to be reported missing, got %v", ref, missing)
+		}
+	}
+	if !containsSubstring(unused, "resource ibm_test, identifier unused") {
+		t.Errorf("Expected the unused validator to be reported, got %v", unused)
+	}
+	if containsSubstring(unused, "resource ibm_test, identifier name") {
+		t.Errorf("Expected the referenced validator not to be reported, got %v", unused)
+	}
+
+	out, err := json.Marshal(validatorDict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `"ValidateFunctionIdentifier":"ValidateNoZeroValues"`) {
+		t.Errorf("Expected the function identifiers to be dumped by name, got %s", out)
+	}
+}
+
+func containsSubstring(list []string, s string) bool {
+	for _, v := range list {
+		if strings.Contains(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,16 +1,40 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"log"
+	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider/fwprovider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
+const providerAddress = "registry.terraform.io/IBM-Cloud/ibm"
+
 func main() {
+	dumpValidators := flag.Bool("dump-validators", false, "Print the validator dictionary as JSON and exit")
+	flag.Parse()
+	if *dumpValidators {
+		out, err := json.MarshalIndent(provider.Validator(), "", "  ")
+		if err != nil {
+			log.Fatalf("[ERROR] Error marshalling the validator dictionary: %s", err)
+		}
+		os.Stdout.Write(append(out, '\n'))
+		return
+	}
+
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
-	})
+	// The SDKv2 provider is muxed with the resources and data sources written
+	// on terraform-plugin-framework, see fwprovider
+	serverFactory, err := fwprovider.ProtoV5ProviderServerFactory(context.Background(), provider.Provider())
+	if err != nil {
+		log.Fatalf("[ERROR] Error creating the provider server: %s", err)
+	}
+	if err := tf5server.Serve(providerAddress, serverFactory); err != nil {
+		log.Fatalf("[ERROR] Error serving the provider: %s", err)
+	}
 }